@@ -0,0 +1,215 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrWeekend = errors.New("weekend must be days of the week leaving at least one business day")
+
+// Weekend is the set of days of the week business is closed on every week.
+type Weekend uint8
+
+const (
+	// SaturdaySunday is the weekend of most of the world.
+	SaturdaySunday Weekend = 1<<time.Saturday | 1<<time.Sunday
+	// FridaySaturday is the weekend of many Middle East markets.
+	FridaySaturday Weekend = 1<<time.Friday | 1<<time.Saturday
+)
+
+// NewWeekend returns the weekend of the days of the week. A weekend may be empty, but can not be every day.
+func NewWeekend(days ...time.Weekday) (Weekend, error) {
+	var weekend Weekend
+
+	for _, day := range days {
+		if day < time.Sunday || day > time.Saturday {
+			return 0, fmt.Errorf("%w: unknown weekday %d", ErrWeekend, day)
+		}
+
+		weekend |= 1 << day
+	}
+
+	if weekend == 1<<daysPerWeek-1 {
+		return 0, fmt.Errorf("%w: every day of the week is in the weekend", ErrWeekend)
+	}
+
+	return weekend, nil
+}
+
+// Contains reports whether the day of the week is in the weekend.
+func (w Weekend) Contains(day time.Weekday) bool {
+	return day >= time.Sunday && day <= time.Saturday && w&(1<<day) != 0
+}
+
+// Days returns the days of the week in the weekend, from Sunday to Saturday.
+func (w Weekend) Days() []time.Weekday {
+	var days []time.Weekday
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if w.Contains(day) {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
+
+// HolidayCalendar tells which dates business is closed on. A calendar has to leave business days open, or the
+// business day arithmetic over it does not end.
+type HolidayCalendar interface {
+	// IsHoliday reports whether business is closed on the date.
+	IsHoliday(date Date) bool
+}
+
+// HolidayFunc is a HolidayCalendar of a function.
+type HolidayFunc func(date Date) bool
+
+// IsHoliday reports whether the function returns true for the date.
+func (f HolidayFunc) IsHoliday(date Date) bool {
+	return f(date)
+}
+
+// Holidays is a HolidayCalendar of a set of dates.
+type Holidays struct {
+	days map[int64]struct{}
+}
+
+// NewHolidays returns the holiday calendar of the dates.
+func NewHolidays(dates ...Date) Holidays {
+	days := make(map[int64]struct{}, len(dates))
+
+	for _, date := range dates {
+		days[unixDays(date)] = struct{}{}
+	}
+
+	return Holidays{days: days}
+}
+
+// IsHoliday reports whether the date is one of the holidays.
+func (h Holidays) IsHoliday(date Date) bool {
+	_, ok := h.days[unixDays(date)]
+
+	return ok
+}
+
+// unionCalendar is closed when any of its calendars is.
+type unionCalendar []HolidayCalendar
+
+// intersectionCalendar is closed when all of its calendars are.
+type intersectionCalendar []HolidayCalendar
+
+// UnionCalendar returns the calendar that is closed when any of the calendars is, for a settlement that needs every
+// market open. Combining BusinessCalendars this way also combines their weekends.
+func UnionCalendar(calendars ...HolidayCalendar) HolidayCalendar {
+	return unionCalendar(calendars)
+}
+
+// IntersectionCalendar returns the calendar that is closed only when all of the calendars are, for work that can be
+// done in any market that is open. It is never closed when there are no calendars.
+func IntersectionCalendar(calendars ...HolidayCalendar) HolidayCalendar {
+	return intersectionCalendar(calendars)
+}
+
+func (u unionCalendar) IsHoliday(date Date) bool {
+	for _, calendar := range u {
+		if calendar.IsHoliday(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i intersectionCalendar) IsHoliday(date Date) bool {
+	for _, calendar := range i {
+		if !calendar.IsHoliday(date) {
+			return false
+		}
+	}
+
+	return len(i) > 0
+}
+
+// BusinessCalendar is the days business is done on: those that are neither in its weekend nor holidays. Its zero
+// value has business on every day.
+type BusinessCalendar struct {
+	// Weekend is the days of the week business is closed on every week.
+	Weekend Weekend
+	// Holidays are the dates business is closed on besides the weekend, or none when nil.
+	Holidays HolidayCalendar
+}
+
+// IsHoliday reports whether business is closed on the date, for its weekend or a holiday, so a BusinessCalendar
+// is a HolidayCalendar that can be combined with others.
+func (c BusinessCalendar) IsHoliday(date Date) bool {
+	return !c.IsBusinessDay(date)
+}
+
+// IsBusinessDay reports whether business is done on the date.
+func (c BusinessCalendar) IsBusinessDay(date Date) bool {
+	if c.Weekend.Contains(date.Weekday()) {
+		return false
+	}
+
+	return c.Holidays == nil || !c.Holidays.IsHoliday(date)
+}
+
+// NextBusinessDay returns the first business day after the date.
+func (c BusinessCalendar) NextBusinessDay(date Date) Date {
+	return dateFromUnixDays(c.step(unixDays(date), 1))
+}
+
+// PreviousBusinessDay returns the last business day before the date.
+func (c BusinessCalendar) PreviousBusinessDay(date Date) Date {
+	return dateFromUnixDays(c.step(unixDays(date), -1))
+}
+
+// AddBusinessDays returns the date the number of business days after the date, or before it when the number is
+// negative, so adding 1 to a Friday or a Saturday gives the Monday after them over a Saturday and Sunday weekend.
+// Adding 0 returns the date, even when it is not a business day.
+func (c BusinessCalendar) AddBusinessDays(date Date, days int) Date {
+	day := unixDays(date)
+
+	for ; days > 0; days-- {
+		day = c.step(day, 1)
+	}
+
+	for ; days < 0; days++ {
+		day = c.step(day, -1)
+	}
+
+	return dateFromUnixDays(day)
+}
+
+// BusinessDaysBetween returns the number of business days after the start up to and including the end, or minus the
+// number of them from the end up to but not including the start when the end is before the start. Adding it to the
+// start with AddBusinessDays gives the end when the end is a business day.
+func (c BusinessCalendar) BusinessDaysBetween(start Date, end Date) int {
+	from, to, sign := unixDays(start)+1, unixDays(end), 1
+
+	if to < from-1 {
+		from, to, sign = to, from-2, -1
+	}
+
+	count := 0
+
+	for day := from; day <= to; day++ {
+		if c.IsBusinessDay(dateFromUnixDays(day)) {
+			count++
+		}
+	}
+
+	return sign * count
+}
+
+// step returns the first business day from the day in the direction, as days since 1970-01-01.
+func (c BusinessCalendar) step(day int64, direction int64) int64 {
+	for {
+		day += direction
+
+		if c.IsBusinessDay(dateFromUnixDays(day)) {
+			return day
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package dte_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleBusinessCalendar_AddBusinessDays() {
+	christmas, err := dte.NewDate("2024-12-25")
+	if err != nil {
+		return
+	}
+
+	calendar := dte.BusinessCalendar{Weekend: dte.SaturdaySunday, Holidays: dte.NewHolidays(christmas)}
+
+	start, err := dte.NewDate("2024-12-20")
+	if err != nil {
+		return
+	}
+
+	due := calendar.AddBusinessDays(start, 5)
+
+	fmt.Println(due, calendar.BusinessDaysBetween(start, due))
+
+	// Output: 2024-12-30 5
+}
+
+func ExampleUnionCalendar() {
+	// A settlement between a Friday and Saturday market and a Saturday and Sunday one needs both open.
+	dubai := dte.BusinessCalendar{Weekend: dte.FridaySaturday, Holidays: nil}
+	london := dte.BusinessCalendar{Weekend: dte.SaturdaySunday, Holidays: nil}
+	settlement := dte.BusinessCalendar{Weekend: 0, Holidays: dte.UnionCalendar(dubai, london)}
+
+	thursday, err := dte.NewDate("2024-06-06")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(settlement.NextBusinessDay(thursday))
+
+	// Output: 2024-06-10
+}
+
+func TestNewWeekend(t *testing.T) {
+	t.Parallel()
+
+	weekend, err := dte.NewWeekend(time.Friday, time.Saturday)
+	if err != nil || weekend != dte.FridaySaturday {
+		t.Errorf("NewWeekend(Friday, Saturday) = %v, %v, want %v", weekend, err, dte.FridaySaturday)
+	}
+
+	if got, want := dte.SaturdaySunday.Days(), []time.Weekday{time.Sunday, time.Saturday}; !slices.Equal(got, want) {
+		t.Errorf("Days() = %v, want %v", got, want)
+	}
+
+	if weekend, err := dte.NewWeekend(); err != nil || weekend.Contains(time.Sunday) {
+		t.Errorf("NewWeekend() = %v, %v, want an empty weekend", weekend, err)
+	}
+
+	every := []time.Weekday{
+		time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+	}
+
+	for _, days := range [][]time.Weekday{every, {time.Weekday(7)}, {time.Weekday(-1)}} {
+		if _, err := dte.NewWeekend(days...); !errors.Is(err, dte.ErrWeekend) {
+			t.Errorf("NewWeekend(%v) error = %v, want %v", days, err, dte.ErrWeekend)
+		}
+	}
+}
+
+func TestBusinessCalendar(t *testing.T) {
+	t.Parallel()
+
+	// Good Friday and Easter Monday in England, 2024.
+	holidays := dte.NewHolidays(mustDate(t, "2024-03-29"), mustDate(t, "2024-04-01"))
+	england := dte.BusinessCalendar{Weekend: dte.SaturdaySunday, Holidays: holidays}
+	uae := dte.BusinessCalendar{Weekend: dte.FridaySaturday, Holidays: nil}
+
+	tests := []struct {
+		name     string
+		calendar dte.BusinessCalendar
+		date     string
+		days     int
+		want     string
+	}{
+		{"zero", england, "2024-03-30", 0, "2024-03-30"},
+		{"within the week", england, "2024-03-25", 3, "2024-03-28"},
+		{"over easter", england, "2024-03-28", 1, "2024-04-02"},
+		{"from a holiday", england, "2024-03-29", 1, "2024-04-02"},
+		{"from the weekend", england, "2024-03-30", 2, "2024-04-03"},
+		{"back over easter", england, "2024-04-02", -1, "2024-03-28"},
+		{"back from the weekend", england, "2024-03-31", -1, "2024-03-28"},
+		{"friday and saturday weekend", uae, "2024-03-28", 1, "2024-03-31"},
+		{"back over friday and saturday", uae, "2024-03-31", -1, "2024-03-28"},
+		{"every day", dte.BusinessCalendar{Weekend: 0, Holidays: nil}, "2024-03-28", 3, "2024-03-31"},
+		{"weeks", england, "2024-04-02", 20, "2024-04-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			date := mustDate(t, tt.date)
+
+			got := tt.calendar.AddBusinessDays(date, tt.days)
+			if got.String() != tt.want {
+				t.Fatalf("AddBusinessDays(%s, %d) = %s, want %s", tt.date, tt.days, got, tt.want)
+			}
+
+			if between := tt.calendar.BusinessDaysBetween(date, got); tt.days != 0 && between != tt.days {
+				t.Errorf("BusinessDaysBetween(%s, %s) = %d, want %d", tt.date, got, between, tt.days)
+			}
+		})
+	}
+}
+
+func TestBusinessCalendarNextPrevious(t *testing.T) {
+	t.Parallel()
+
+	calendar := dte.BusinessCalendar{Weekend: dte.SaturdaySunday, Holidays: dte.NewHolidays(mustDate(t, "2024-01-01"))}
+
+	tests := []struct {
+		date     string
+		business bool
+		next     string
+		previous string
+	}{
+		{"2023-12-29", true, "2024-01-02", "2023-12-28"},
+		{"2023-12-30", false, "2024-01-02", "2023-12-29"},
+		{"2024-01-01", false, "2024-01-02", "2023-12-29"},
+		{"2024-01-02", true, "2024-01-03", "2023-12-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			t.Parallel()
+
+			date := mustDate(t, tt.date)
+
+			if got := calendar.IsBusinessDay(date); got != tt.business {
+				t.Errorf("IsBusinessDay() = %v, want %v", got, tt.business)
+			}
+
+			if got := calendar.IsHoliday(date); got == tt.business {
+				t.Errorf("IsHoliday() = %v, want %v", got, !tt.business)
+			}
+
+			if got := calendar.NextBusinessDay(date); got.String() != tt.next {
+				t.Errorf("NextBusinessDay() = %s, want %s", got, tt.next)
+			}
+
+			if got := calendar.PreviousBusinessDay(date); got.String() != tt.previous {
+				t.Errorf("PreviousBusinessDay() = %s, want %s", got, tt.previous)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	calendar := dte.BusinessCalendar{Weekend: dte.SaturdaySunday, Holidays: nil}
+
+	tests := []struct {
+		start string
+		end   string
+		want  int
+	}{
+		{"2024-06-03", "2024-06-03", 0},
+		{"2024-06-03", "2024-06-04", 1},
+		{"2024-06-04", "2024-06-03", -1},
+		{"2024-06-07", "2024-06-10", 1},
+		{"2024-06-10", "2024-06-07", -1},
+		{"2024-06-08", "2024-06-09", 0},
+		{"2024-06-09", "2024-06-08", 0},
+		{"2024-06-01", "2024-07-01", 21},
+		{"2024-07-01", "2024-06-01", -20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.start+"/"+tt.end, func(t *testing.T) {
+			t.Parallel()
+
+			if got := calendar.BusinessDaysBetween(mustDate(t, tt.start), mustDate(t, tt.end)); got != tt.want {
+				t.Errorf("BusinessDaysBetween() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinedCalendars(t *testing.T) {
+	t.Parallel()
+
+	newYear := mustDate(t, "2025-01-01")
+	boxingDay := mustDate(t, "2024-12-26")
+	christmas := mustDate(t, "2024-12-25")
+
+	us := dte.NewHolidays(christmas, newYear)
+	uk := dte.NewHolidays(christmas, boxingDay, newYear)
+	weekdays := dte.HolidayFunc(func(date dte.Date) bool { return date.Weekday() == time.Wednesday })
+
+	tests := []struct {
+		name     string
+		calendar dte.HolidayCalendar
+		date     dte.Date
+		want     bool
+	}{
+		{"union of both", dte.UnionCalendar(us, uk), christmas, true},
+		{"union of one", dte.UnionCalendar(us, uk), boxingDay, true},
+		{"union of none", dte.UnionCalendar(us, uk), mustDate(t, "2024-12-27"), false},
+		{"empty union", dte.UnionCalendar(), christmas, false},
+		{"intersection of both", dte.IntersectionCalendar(us, uk), christmas, true},
+		{"intersection of one", dte.IntersectionCalendar(us, uk), boxingDay, false},
+		{"empty intersection", dte.IntersectionCalendar(), christmas, false},
+		{"func", dte.IntersectionCalendar(uk, weekdays), newYear, true},
+		{"func not", dte.IntersectionCalendar(uk, weekdays), boxingDay, false},
+		{"nested", dte.UnionCalendar(dte.IntersectionCalendar(us, uk), weekdays), boxingDay, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.calendar.IsHoliday(tt.date); got != tt.want {
+				t.Errorf("IsHoliday(%s) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func mustDate(t *testing.T, value string) dte.Date {
+	t.Helper()
+
+	date, err := dte.NewDate(value)
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	return date
+}
@@ -0,0 +1,289 @@
+package dte
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock is where the current time, today's date and timers come from. Code that takes a Clock instead of calling
+// time.Now can be given a FakeClock in tests, so what it does around midnight or a daylight saving time change does
+// not depend on when the tests run.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Today returns the current date in the location.
+	Today(location *time.Location) Date
+	// NewTimer returns a timer that sends the current time on its channel after at least the duration.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event timer of a Clock.
+type Timer interface {
+	// C returns the channel the time is sent on when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing and reports whether it stopped it before it fired.
+	Stop() bool
+}
+
+// systemClock is the Clock of the system time.
+type systemClock struct{}
+
+// systemTimer is a Timer of the system time.
+type systemTimer struct {
+	timer *time.Timer
+}
+
+// SystemClock returns the Clock of the system time.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Today(location *time.Location) Date {
+	return Date{civilDate(time.Now().In(location))}
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{timer: time.NewTimer(d)}
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// FakeClock is a Clock for tests whose time is frozen until it is advanced or set, or until it is resumed to run at
+// the rate of the system time. Its timers fire when its time reaches them, on the goroutine that moves the time. The
+// zero FakeClock is frozen at the zero time.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	running bool
+	// since is the system time the clock last resumed or moved at while it runs.
+	since  time.Time
+	timers []*fakeTimer
+	// wake fires the earliest timer while the clock runs.
+	wake *time.Timer
+	// changed is closed whenever a timer is added or removed, to wake WaitForTimers.
+	changed chan struct{}
+}
+
+// fakeTimer is a Timer of a FakeClock.
+type fakeTimer struct {
+	clock *FakeClock
+	at    time.Time
+	c     chan time.Time
+}
+
+// NewFakeClock returns a FakeClock frozen at the time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.nowLocked()
+}
+
+// Today returns the date of the clock in the location.
+func (c *FakeClock) Today(location *time.Location) Date {
+	return Date{civilDate(c.Now().In(location))}
+}
+
+// NewTimer returns a timer that fires when the time of the clock has moved on by at least the duration. A timer for
+// a duration that is not positive fires at once.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.nowLocked()
+	timer := &fakeTimer{clock: c, at: now.Add(d), c: make(chan time.Time, 1)}
+
+	if d <= 0 {
+		timer.c <- now
+
+		return timer
+	}
+
+	c.timers = append(c.timers, timer)
+	c.changedLocked()
+	c.scheduleLocked()
+
+	return timer
+}
+
+// Advance moves the time of the clock forward by the duration and fires the timers it reaches.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setLocked(c.nowLocked().Add(d))
+}
+
+// Set moves the time of the clock to the given one, which may be before it, and fires the timers it reaches.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setLocked(now)
+}
+
+// Freeze stops the time of the clock where it is.
+func (c *FakeClock) Freeze() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.nowLocked()
+	c.running = false
+	c.scheduleLocked()
+}
+
+// Resume lets the time of the clock run on at the rate of the system time, firing timers as it reaches them, until
+// it is frozen again.
+func (c *FakeClock) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.running {
+		c.running = true
+		c.since = time.Now()
+		c.scheduleLocked()
+	}
+}
+
+// Timers returns the number of timers waiting to fire.
+func (c *FakeClock) Timers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.timers)
+}
+
+// WaitForTimers waits until at least n timers are waiting to fire, or until the context is done, so a test knows
+// that the code it drives is waiting before it moves the time.
+func (c *FakeClock) WaitForTimers(ctx context.Context, n int) error {
+	for {
+		c.mu.Lock()
+		waiting, changed := len(c.timers), c.changesLocked()
+		c.mu.Unlock()
+
+		if waiting >= n {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck
+		case <-changed:
+		}
+	}
+}
+
+// nowLocked returns the time of the clock, which moves with the system time while the clock runs.
+func (c *FakeClock) nowLocked() time.Time {
+	if c.running {
+		return c.now.Add(time.Since(c.since))
+	}
+
+	return c.now
+}
+
+// setLocked moves the time of the clock and fires the timers it reaches.
+func (c *FakeClock) setLocked(now time.Time) {
+	c.now = now
+	c.since = time.Now()
+
+	c.fireLocked()
+	c.scheduleLocked()
+}
+
+// fireLocked fires the timers the time of the clock has reached.
+func (c *FakeClock) fireLocked() {
+	now := c.nowLocked()
+	before := len(c.timers)
+
+	c.timers = slices.DeleteFunc(c.timers, func(timer *fakeTimer) bool {
+		if timer.at.After(now) {
+			return false
+		}
+
+		timer.c <- now
+
+		return true
+	})
+
+	if len(c.timers) != before {
+		c.changedLocked()
+	}
+}
+
+// scheduleLocked sets the system timer that fires the earliest timer while the clock runs.
+func (c *FakeClock) scheduleLocked() {
+	if c.wake != nil {
+		c.wake.Stop()
+		c.wake = nil
+	}
+
+	if !c.running || len(c.timers) == 0 {
+		return
+	}
+
+	earliest := slices.MinFunc(c.timers, func(a *fakeTimer, b *fakeTimer) int { return a.at.Compare(b.at) })
+
+	c.wake = time.AfterFunc(earliest.at.Sub(c.nowLocked()), func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.fireLocked()
+		c.scheduleLocked()
+	})
+}
+
+// changedLocked wakes the goroutines waiting for the timers to change.
+func (c *FakeClock) changedLocked() {
+	if c.changed != nil {
+		close(c.changed)
+		c.changed = nil
+	}
+}
+
+// changesLocked returns the channel that is closed when the timers change next.
+func (c *FakeClock) changesLocked() <-chan struct{} {
+	if c.changed == nil {
+		c.changed = make(chan struct{})
+	}
+
+	return c.changed
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	before := len(t.clock.timers)
+	t.clock.timers = slices.DeleteFunc(t.clock.timers, func(timer *fakeTimer) bool { return timer == t })
+
+	if len(t.clock.timers) == before {
+		return false
+	}
+
+	t.clock.changedLocked()
+	t.clock.scheduleLocked()
+
+	return true
+}
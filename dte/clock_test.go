@@ -0,0 +1,240 @@
+package dte_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleFakeClock() {
+	clock := dte.NewFakeClock(time.Date(2024, time.March, 31, 23, 30, 0, 0, time.UTC))
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(clock.Today(time.UTC), clock.Today(tokyo))
+
+	clock.Advance(time.Hour)
+	fmt.Println(clock.Today(time.UTC), clock.Today(tokyo))
+
+	// Output:
+	// 2024-03-31 2024-04-01
+	// 2024-04-01 2024-04-01
+}
+
+func TestSystemClock(t *testing.T) {
+	t.Parallel()
+
+	clock := dte.SystemClock()
+
+	if now := clock.Now(); time.Since(now) > time.Second || time.Since(now) < 0 {
+		t.Errorf("Now() = %v, want about %v", now, time.Now())
+	}
+
+	before := time.Now().UTC()
+	today := clock.Today(time.UTC)
+	after := time.Now().UTC()
+
+	if today.Format(time.DateOnly) != before.Format(time.DateOnly) &&
+		today.Format(time.DateOnly) != after.Format(time.DateOnly) {
+		t.Errorf("Today() = %v, want %v", today, before.Format(time.DateOnly))
+	}
+
+	start := time.Now()
+
+	fired := <-clock.NewTimer(10 * time.Millisecond).C()
+	if fired.Sub(start) < 10*time.Millisecond {
+		t.Errorf("NewTimer() fired after %v, want at least 10ms", fired.Sub(start))
+	}
+
+	timer := clock.NewTimer(time.Hour)
+	if !timer.Stop() {
+		t.Errorf("Stop() = false, want true for a timer that has not fired")
+	}
+}
+
+func TestFakeClockToday(t *testing.T) {
+	t.Parallel()
+
+	newYork := mustLoadLocation(t, "America/New_York")
+	kiritimati := mustLoadLocation(t, "Pacific/Kiritimati")
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		location *time.Location
+		want     string
+	}{
+		{"utc", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), time.UTC, "2024-01-01"},
+		{"before midnight", time.Date(2024, time.January, 1, 4, 59, 59, 0, time.UTC), newYork, "2023-12-31"},
+		{"at midnight", time.Date(2024, time.January, 1, 5, 0, 0, 0, time.UTC), newYork, "2024-01-01"},
+		{"ahead of utc", time.Date(2023, time.December, 31, 10, 0, 0, 0, time.UTC), kiritimati, "2024-01-01"},
+		{"leap day", time.Date(2024, time.February, 29, 23, 0, 0, 0, newYork), newYork, "2024-02-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := dte.NewFakeClock(tt.now).Today(tt.location); got.String() != tt.want {
+				t.Errorf("Today() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakeClockTimers(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clock := dte.NewFakeClock(start)
+
+	now := clock.NewTimer(0)
+	if got := receive(t, now); !got.Equal(start) {
+		t.Errorf("NewTimer(0) fired at %v, want %v", got, start)
+	}
+
+	minute := clock.NewTimer(time.Minute)
+	hour := clock.NewTimer(time.Hour)
+	stopped := clock.NewTimer(time.Second)
+
+	if !stopped.Stop() {
+		t.Errorf("Stop() = false, want true for a timer that has not fired")
+	}
+
+	if got := clock.Timers(); got != 2 {
+		t.Errorf("Timers() = %d, want 2", got)
+	}
+
+	clock.Advance(59 * time.Second)
+	assertNotFired(t, minute)
+
+	clock.Advance(2 * time.Second)
+
+	if got, want := receive(t, minute), start.Add(61*time.Second); !got.Equal(want) {
+		t.Errorf("minute timer fired at %v, want %v", got, want)
+	}
+
+	if minute.Stop() {
+		t.Errorf("Stop() = true, want false for a timer that has fired")
+	}
+
+	clock.Set(start)
+	assertNotFired(t, hour)
+
+	clock.Set(start.Add(2 * time.Hour))
+
+	if got, want := receive(t, hour), start.Add(2*time.Hour); !got.Equal(want) {
+		t.Errorf("hour timer fired at %v, want %v", got, want)
+	}
+
+	assertNotFired(t, stopped)
+
+	if got := clock.Timers(); got != 0 {
+		t.Errorf("Timers() = %d, want 0", got)
+	}
+}
+
+func TestFakeClockFreezeResume(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clock := dte.NewFakeClock(start)
+
+	time.Sleep(5 * time.Millisecond)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("Now() = %v, want the frozen %v", got, start)
+	}
+
+	timer := clock.NewTimer(10 * time.Millisecond)
+
+	clock.Resume()
+
+	if got := receive(t, timer); got.Before(start.Add(10 * time.Millisecond)) {
+		t.Errorf("timer fired at %v, want at least %v", got, start.Add(10*time.Millisecond))
+	}
+
+	clock.Freeze()
+
+	frozen := clock.Now()
+
+	time.Sleep(5 * time.Millisecond)
+
+	if got := clock.Now(); !got.Equal(frozen) {
+		t.Errorf("Now() = %v after Freeze, want %v", got, frozen)
+	}
+
+	// Moving a running clock keeps it running from the new time.
+	clock.Resume()
+	clock.Set(start)
+
+	if got := clock.Now(); got.Before(start) || got.Sub(start) > time.Second {
+		t.Errorf("Now() = %v after Set, want about %v", got, start)
+	}
+}
+
+func TestFakeClockWaitForTimers(t *testing.T) {
+	t.Parallel()
+
+	clock := dte.NewFakeClock(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	go func() {
+		clock.NewTimer(time.Minute)
+		clock.NewTimer(time.Hour)
+	}()
+
+	if err := clock.WaitForTimers(ctx, 2); err != nil {
+		t.Errorf("WaitForTimers() error = %v", err)
+	}
+
+	short, cancelShort := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelShort()
+
+	if err := clock.WaitForTimers(short, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForTimers() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func receive(t *testing.T, timer dte.Timer) time.Time {
+	t.Helper()
+
+	select {
+	case fired := <-timer.C():
+		return fired
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timer did not fire")
+
+		return time.Time{}
+	}
+}
+
+func assertNotFired(t *testing.T, timer dte.Timer) {
+	t.Helper()
+
+	select {
+	case fired := <-timer.C():
+		t.Errorf("timer fired at %v, want it waiting", fired)
+	default:
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	return location
+}
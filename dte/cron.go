@@ -0,0 +1,447 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrCronParse = errors.New("cron expression does not follow the 5 or 6 field cron format")
+
+const (
+	cronFields            = 5
+	cronFieldsWithSeconds = 6
+	maxCronWeekday        = 7
+)
+
+// cronField is a field of a cron expression and the values it can hold.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	cronSecond = cronField{name: "second", min: 0, max: isoMaxSecond, names: nil}      //nolint:gochecknoglobals
+	cronMinute = cronField{name: "minute", min: 0, max: isoMaxMinute, names: nil}      //nolint:gochecknoglobals
+	cronHour   = cronField{name: "hour", min: 0, max: isoMaxHour, names: nil}          //nolint:gochecknoglobals
+	cronDay    = cronField{name: "day of month", min: 1, max: maxMonthDay, names: nil} //nolint:gochecknoglobals
+	cronMonth  = cronField{                                                            //nolint:gochecknoglobals
+		name:  "month",
+		min:   1,
+		max:   12, //nolint:mnd
+		names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"},
+	}
+	cronWeekday = cronField{ //nolint:gochecknoglobals
+		name:  "day of week",
+		min:   0,
+		max:   maxCronWeekday,
+		names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+	}
+	// cronDescriptors are the @ shorthands for common expressions.
+	cronDescriptors = map[string]string{ //nolint:gochecknoglobals
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// Cron is a cron expression such as 30 9 * * MON-FRI for 09:30 on weekdays. It has five fields, minute, hour, day of
+// month, month and day of week, or six with a leading second field, or is one of @yearly, @annually, @monthly,
+// @weekly, @daily, @midnight and @hourly. Fields hold *, values, ranges such as 1-5, steps such as */15 or 0-30/10,
+// and comma separated lists of those, with JAN-DEC and SUN-SAT names and 7 for Sunday. ? is the same as * in the day
+// fields. When both day fields are restricted a day matches either one, as in Vixie cron. Its zero value is no
+// expression at all.
+type Cron struct { //nolint:recvcheck
+	expression string
+	seconds    uint64
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+	// anyDay and anyWeekday are set when the day fields start with * or ?, so a day only has to match the other one.
+	anyDay     bool
+	anyWeekday bool
+	// fixedTime is set when none of the second, minute and hour fields start with *, so the expression runs at fixed
+	// wall clock times of the day.
+	fixedTime bool
+}
+
+// ParseCron parses a cron expression. An error names the field that is not valid, such as a day of month of 32 or a
+// step of 0, and an expression that can never run, such as 0 0 30 2 *, is not valid either.
+func ParseCron(s string) (Cron, error) {
+	fields := strings.Fields(s)
+
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		expression, ok := cronDescriptors[strings.ToLower(fields[0])]
+		if !ok {
+			return Cron{}, fmt.Errorf("ParseCron %q: %w: unknown descriptor %s", s, ErrCronParse, fields[0])
+		}
+
+		cron, err := ParseCron(expression)
+		if err != nil {
+			return Cron{}, err
+		}
+
+		cron.expression = strings.ToLower(fields[0])
+
+		return cron, nil
+	}
+
+	cron := Cron{expression: strings.Join(fields, " ")}
+
+	switch len(fields) {
+	case cronFields:
+		fields = append([]string{"0"}, fields...)
+	case cronFieldsWithSeconds:
+	default:
+		return Cron{}, fmt.Errorf("ParseCron %q: %w: it has %d fields instead of 5 or 6", s, ErrCronParse, len(fields))
+	}
+
+	var err error
+
+	targets := []*uint64{&cron.seconds, &cron.minutes, &cron.hours, &cron.days, &cron.months, &cron.weekdays}
+	for i, field := range []cronField{cronSecond, cronMinute, cronHour, cronDay, cronMonth, cronWeekday} {
+		*targets[i], err = field.parse(fields[i])
+		if err != nil {
+			return Cron{}, fmt.Errorf("ParseCron %q: %w", s, err)
+		}
+	}
+
+	// Sunday is both 0 and 7.
+	cron.weekdays = (cron.weekdays | cron.weekdays>>maxCronWeekday) &^ (1 << maxCronWeekday)
+	cron.anyDay = strings.HasPrefix(fields[3], "*") || strings.HasPrefix(fields[3], "?")
+	cron.anyWeekday = strings.HasPrefix(fields[5], "*") || strings.HasPrefix(fields[5], "?")
+	cron.fixedTime = !strings.HasPrefix(fields[0], "*") && !strings.HasPrefix(fields[1], "*") &&
+		!strings.HasPrefix(fields[2], "*")
+
+	if cron.anyWeekday && !cron.possibleDay() {
+		return Cron{}, fmt.Errorf("ParseCron %q: %w: day of month field %q is never in the month field %q", s,
+			ErrCronParse, fields[3], fields[4])
+	}
+
+	return cron, nil
+}
+
+// IsZero reports whether c is the zero Cron.
+func (c Cron) IsZero() bool {
+	return c.expression == ""
+}
+
+// Next returns the first time after the given one that the expression runs at in the location, and false when
+// there is none before the year 10000. A wall clock time that a daylight saving time change skips runs when the
+// change happens, and one it repeats runs the first time only, unless the second, minute or hour field starts with *.
+// Those expressions run every hour or more often and simply run at the wall clock times that do exist, as in Vixie
+// cron.
+func (c Cron) Next(after time.Time, location *time.Location) (time.Time, bool) {
+	if c.IsZero() {
+		return time.Time{}, false
+	}
+
+	t := after.In(location)
+	from := cronCivil(t).Truncate(time.Second).Add(time.Second)
+	endOfTime := time.Date(maxYear+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for {
+		start, end := t.ZoneBounds()
+		_, offset := t.Zone()
+		limit := endOfTime
+
+		if !end.IsZero() {
+			limit = cronCivil(end.In(time.FixedZone("", offset)))
+		}
+
+		for match, ok := c.nextCivil(from, limit); ok; match, ok = c.nextCivil(match.Add(time.Second), limit) {
+			instant := match.Add(-time.Duration(offset) * time.Second).In(location)
+			if !c.fixedTime || !repeatedWallClock(match, start) {
+				return instant, true
+			}
+		}
+
+		if end.IsZero() {
+			return time.Time{}, false
+		}
+
+		from = cronCivil(end)
+
+		// The wall clock times skipped by a change to a later offset run when it happens.
+		if c.fixedTime {
+			if _, ok := c.nextCivil(limit, from); ok {
+				return end, true
+			}
+		}
+
+		t = end
+	}
+}
+
+// Occurrences returns the times after the given one that the expression runs at in the location, as Next finds
+// them. The sequence stops at the year 9999.
+func (c Cron) Occurrences(after time.Time, location *time.Location) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for {
+			next, ok := c.Next(after, location)
+			if !ok || !yield(next) {
+				return
+			}
+
+			after = next
+		}
+	}
+}
+
+// OccurrencesOn returns the times that the expression runs at on the date in the location.
+func (c Cron) OccurrencesOn(d Date, location *time.Location) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		year, month, day := d.Date()
+		end := time.Date(year, month, day+1, 0, 0, 0, 0, location)
+
+		for occurrence := range c.Occurrences(cronBeforeMidnight(d, location), location) {
+			if !occurrence.Before(end) || !yield(occurrence) {
+				return
+			}
+		}
+	}
+}
+
+// Dates returns the dates, from the given one on, that the expression runs at least once on in the location. The
+// sequence stops at the year 9999.
+func (c Cron) Dates(from Date, location *time.Location) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for {
+			next, ok := c.Next(cronBeforeMidnight(from, location), location)
+			if !ok {
+				return
+			}
+
+			year, month, day := next.Date()
+			from = Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+
+			if !yield(from) {
+				return
+			}
+
+			from = Date{Time: from.AddDate(0, 0, 1)}
+		}
+	}
+}
+
+// String returns the expression with its fields separated by single spaces, as it was parsed, or an empty string for
+// the zero Cron.
+func (c Cron) String() string {
+	return c.expression
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The expression is a quoted string, or null for the zero Cron.
+func (c Cron) MarshalJSON() ([]byte, error) {
+	if c.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + c.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The expression must be a quoted string, null leaves it unchanged.
+func (c *Cron) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("Cron.UnmarshalJSON: %w: input is not a JSON string", ErrCronParse)
+	}
+
+	return c.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (c Cron) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (c *Cron) UnmarshalText(data []byte) error {
+	parsed, err := ParseCron(string(data))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// parse returns the values of the field text as bits.
+func (f cronField) parse(text string) (uint64, error) {
+	var set uint64
+
+	for _, item := range strings.Split(text, ",") {
+		low, high, step, err := f.parseItem(item)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s field %q: %w", ErrCronParse, f.name, text, err)
+		}
+
+		for value := low; value <= high; value += step {
+			set |= 1 << value
+		}
+	}
+
+	return set, nil
+}
+
+// parseItem returns the range and step of an item of a comma separated field.
+func (f cronField) parseItem(item string) (int, int, int, error) {
+	span, stepText, hasStep := strings.Cut(item, "/")
+	step := 1
+
+	if hasStep {
+		var err error
+
+		step, err = strconv.Atoi(stepText)
+		if err != nil || step < 1 {
+			return 0, 0, 0, fmt.Errorf("step %q is not a positive number", stepText)
+		}
+	}
+
+	if span == "*" || span == "?" && (f.name == cronDay.name || f.name == cronWeekday.name) {
+		return f.min, f.max, step, nil
+	}
+
+	lowText, highText, isRange := strings.Cut(span, "-")
+
+	low, err := f.value(lowText)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	high := low
+
+	switch {
+	case isRange:
+		high, err = f.value(highText)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+
+		if high < low {
+			return 0, 0, 0, fmt.Errorf("range %s ends before it starts", span)
+		}
+	case hasStep:
+		high = f.max
+	}
+
+	return low, high, step, nil
+}
+
+// value parses a number or name of the field.
+func (f cronField) value(text string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(text, name) {
+			return i + f.min, nil
+		}
+	}
+
+	value, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number or name", text)
+	}
+
+	if value < f.min || value > f.max {
+		return 0, fmt.Errorf("%d is not within %d-%d", value, f.min, f.max)
+	}
+
+	return value, nil
+}
+
+// possibleDay reports whether a day of month of the expression is in one of its months, taking February as 29 days
+// long.
+func (c Cron) possibleDay() bool {
+	for month := time.January; month <= time.December; month++ {
+		if c.months&(1<<month) != 0 && bits.TrailingZeros64(c.days) <= (YearMonth{year: leapYear, month: month}).Days() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dayMatches reports whether the day fields match the date, with either one matching when both are restricted.
+func (c Cron) dayMatches(t time.Time) bool {
+	day := c.days&(1<<t.Day()) != 0
+	weekday := c.weekdays&(1<<t.Weekday()) != 0
+
+	if c.anyDay || c.anyWeekday {
+		return day && weekday
+	}
+
+	return day || weekday
+}
+
+// nextCivil returns the first wall clock time, given in UTC, from the given one and before the limit that matches the
+// expression.
+func (c Cron) nextCivil(from time.Time, limit time.Time) (time.Time, bool) {
+	t := from
+
+	for t.Before(limit) {
+		year, month, day := t.Date()
+
+		switch {
+		case c.months&(1<<month) == 0:
+			t = time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.dayMatches(t):
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+		case c.hours&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minutes&(1<<t.Minute()) == 0:
+			t = t.Truncate(time.Minute).Add(time.Minute)
+		case c.seconds&(1<<t.Second()) == 0:
+			t = t.Add(time.Second)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// repeatedWallClock reports whether the wall clock time, given in UTC, also happened with the offset in effect before
+// the zone that starts at the given time, as it does for the hour repeated when daylight saving time ends.
+func repeatedWallClock(wall time.Time, zoneStart time.Time) bool {
+	if zoneStart.IsZero() {
+		return false
+	}
+
+	before := zoneStart.Add(-time.Second)
+	previousStart, _ := before.ZoneBounds()
+	_, previousOffset := before.Zone()
+	instant := wall.Add(-time.Duration(previousOffset) * time.Second)
+
+	return instant.Before(zoneStart) && (previousStart.IsZero() || !instant.Before(previousStart))
+}
+
+// cronCivil returns the wall clock of t in UTC.
+func cronCivil(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, t.Nanosecond(), time.UTC)
+}
+
+// cronBeforeMidnight returns the instant just before the start of the date in the location.
+func cronBeforeMidnight(d Date, location *time.Location) time.Time {
+	year, month, day := d.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, location).Add(-time.Nanosecond)
+}
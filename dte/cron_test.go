@@ -0,0 +1,378 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseCron() {
+	cron, err := dte.ParseCron("30 9 * * MON-FRI")
+	if err != nil {
+		return
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		return
+	}
+
+	after := time.Date(2024, time.March, 29, 12, 0, 0, 0, berlin)
+
+	for occurrence := range cron.Occurrences(after, berlin) {
+		fmt.Println(occurrence.Format(time.RFC3339))
+
+		if occurrence.Day() == 2 {
+			break
+		}
+	}
+
+	// Output:
+	// 2024-04-01T09:30:00+02:00
+	// 2024-04-02T09:30:00+02:00
+}
+
+func ExampleCron_Dates() {
+	cron, err := dte.ParseCron("0 0 29 2 *")
+	if err != nil {
+		return
+	}
+
+	from, err := dte.NewDate("2024-03-01")
+	if err != nil {
+		return
+	}
+
+	for date := range cron.Dates(from, time.UTC) {
+		fmt.Println(date)
+
+		if date.Year() > 2030 {
+			break
+		}
+	}
+
+	// Output:
+	// 2028-02-29
+	// 2032-02-29
+}
+
+func ExampleCron_json() {
+	type Job struct {
+		Schedule dte.Cron `json:"schedule"`
+	}
+
+	var job Job
+
+	err := json.Unmarshal([]byte(`{"schedule":"@DAILY"}`), &job)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(job)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled))
+
+	// Output: {"schedule":"@daily"}
+}
+
+func TestCronNext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		after      string
+		want       []string
+	}{
+		{
+			name:       "every fifteen minutes",
+			expression: "*/15 * * * *",
+			after:      "2024-01-01T23:40:00Z",
+			want:       []string{"2024-01-01T23:45:00Z", "2024-01-02T00:00:00Z", "2024-01-02T00:15:00Z"},
+		},
+		{
+			name:       "strictly after",
+			expression: "0 12 * * *",
+			after:      "2024-01-01T12:00:00Z",
+			want:       []string{"2024-01-02T12:00:00Z", "2024-01-03T12:00:00Z"},
+		},
+		{
+			name:       "seconds field",
+			expression: "10,50 0 0 1 1 ?",
+			after:      "2024-06-01T00:00:00Z",
+			want:       []string{"2025-01-01T00:00:10Z", "2025-01-01T00:00:50Z", "2026-01-01T00:00:10Z"},
+		},
+		{
+			name:       "either day field when both are restricted",
+			expression: "0 0 13 * FRI",
+			after:      "2024-09-10T00:00:00Z",
+			want:       []string{"2024-09-13T00:00:00Z", "2024-09-20T00:00:00Z", "2024-09-27T00:00:00Z"},
+		},
+		{
+			name:       "both day fields when one starts with a star",
+			expression: "0 0 */2 * 5",
+			after:      "2024-09-10T00:00:00Z",
+			want:       []string{"2024-09-13T00:00:00Z", "2024-09-27T00:00:00Z", "2024-10-11T00:00:00Z"},
+		},
+		{
+			name:       "sunday as 7 and names",
+			expression: "0 8 * jan-feb 7",
+			after:      "2024-01-20T00:00:00Z",
+			want:       []string{"2024-01-21T08:00:00Z", "2024-01-28T08:00:00Z", "2024-02-04T08:00:00Z"},
+		},
+		{
+			name:       "range with step and list",
+			expression: "0 8-18/5,23 * * *",
+			after:      "2024-01-01T07:00:00Z",
+			want:       []string{"2024-01-01T08:00:00Z", "2024-01-01T13:00:00Z", "2024-01-01T18:00:00Z", "2024-01-01T23:00:00Z"},
+		},
+		{
+			name:       "value with step runs to the end of the field",
+			expression: "0 0 0 28/2 * *",
+			after:      "2024-02-01T00:00:00Z",
+			want:       []string{"2024-02-28T00:00:00Z", "2024-03-28T00:00:00Z", "2024-03-30T00:00:00Z"},
+		},
+		{
+			name:       "descriptor",
+			expression: "@weekly",
+			after:      "2024-01-01T00:00:00Z",
+			want:       []string{"2024-01-07T00:00:00Z", "2024-01-14T00:00:00Z"},
+		},
+		{
+			name:       "sub-second after",
+			expression: "* * * * * *",
+			after:      "2024-01-01T00:00:00.5Z",
+			want:       []string{"2024-01-01T00:00:01Z", "2024-01-01T00:00:02Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assertCronOccurrences(t, tt.expression, tt.after, time.UTC, tt.want)
+		})
+	}
+}
+
+func TestCronNextInTimeZone(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	lordHowe, err := time.LoadLocation("Australia/Lord_Howe")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		after      string
+		location   *time.Location
+		want       []string
+	}{
+		{
+			name:       "skipped wall clock runs at the change",
+			expression: "30 2 * * *",
+			after:      "2024-03-09T12:00:00-05:00",
+			location:   newYork,
+			want:       []string{"2024-03-10T03:00:00-04:00", "2024-03-11T02:30:00-04:00"},
+		},
+		{
+			name:       "skipped wall clocks run once at the change",
+			expression: "0,30 2,3 * * *",
+			after:      "2024-03-10T01:00:00-05:00",
+			location:   newYork,
+			want:       []string{"2024-03-10T03:00:00-04:00", "2024-03-10T03:30:00-04:00", "2024-03-11T02:00:00-04:00"},
+		},
+		{
+			name:       "repeated wall clock runs the first time",
+			expression: "30 1 * * *",
+			after:      "2024-11-02T12:00:00-04:00",
+			location:   newYork,
+			want:       []string{"2024-11-03T01:30:00-04:00", "2024-11-04T01:30:00-05:00"},
+		},
+		{
+			name:       "frequent expressions skip the gap",
+			expression: "*/30 * * * *",
+			after:      "2024-03-10T01:00:00-05:00",
+			location:   newYork,
+			want:       []string{"2024-03-10T01:30:00-05:00", "2024-03-10T03:00:00-04:00", "2024-03-10T03:30:00-04:00"},
+		},
+		{
+			name:       "frequent expressions run twice in the repeated hour",
+			expression: "0 * * * *",
+			after:      "2024-11-03T00:30:00-04:00",
+			location:   newYork,
+			want: []string{
+				"2024-11-03T01:00:00-04:00", "2024-11-03T01:00:00-05:00", "2024-11-03T02:00:00-05:00",
+			},
+		},
+		{
+			name:       "half hour change",
+			expression: "45 1 * * *",
+			after:      "2024-04-06T12:00:00+11:00",
+			location:   lordHowe,
+			want:       []string{"2024-04-07T01:45:00+11:00", "2024-04-08T01:45:00+10:30"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assertCronOccurrences(t, tt.expression, tt.after, tt.location, tt.want)
+		})
+	}
+}
+
+func TestCronOccurrencesOn(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	cron, err := dte.ParseCron("0 0,12 * * *")
+	if err != nil {
+		t.Fatalf("ParseCron() error = %v", err)
+	}
+
+	date, err := dte.NewDate("2024-03-10")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	var got []string
+	for occurrence := range cron.OccurrencesOn(date, newYork) {
+		got = append(got, occurrence.Format(time.RFC3339))
+	}
+
+	if want := "2024-03-10T00:00:00-05:00 2024-03-10T12:00:00-04:00"; strings.Join(got, " ") != want {
+		t.Errorf("OccurrencesOn() = %v, want %s", got, want)
+	}
+
+	var dates []string
+
+	for day := range cron.Dates(date, newYork) {
+		if dates = append(dates, day.String()); len(dates) == 2 {
+			break
+		}
+	}
+
+	if want := "2024-03-10 2024-03-11"; strings.Join(dates, " ") != want {
+		t.Errorf("Dates() = %v, want %s", dates, want)
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expression string
+		wantField  string
+	}{
+		{expression: "", wantField: "0 fields"},
+		{expression: "* * * *", wantField: "4 fields"},
+		{expression: "* * * * * * *", wantField: "7 fields"},
+		{expression: "@reboot", wantField: "descriptor"},
+		{expression: "60 * * * *", wantField: `minute field "60"`},
+		{expression: "0 24 * * *", wantField: `hour field "24"`},
+		{expression: "0 0 32 * *", wantField: `day of month field "32"`},
+		{expression: "0 0 0 * *", wantField: `day of month field "0"`},
+		{expression: "0 0 * 13 *", wantField: `month field "13"`},
+		{expression: "0 0 * FOO *", wantField: `month field "FOO"`},
+		{expression: "0 0 * * 8", wantField: `day of week field "8"`},
+		{expression: "0 0 * * MON-", wantField: `day of week field "MON-"`},
+		{expression: "0 0 * * 5-1", wantField: `day of week field "5-1"`},
+		{expression: "*/0 * * * *", wantField: `minute field "*/0"`},
+		{expression: "? * * * *", wantField: `minute field "?"`},
+		{expression: "60 0 0 * * *", wantField: `second field "60"`},
+		{expression: "0 0 1,,2 * *", wantField: `day of month field "1,,2"`},
+		{expression: "0 0 30 2 *", wantField: `day of month field "30" is never in the month field "2"`},
+		{expression: "0 0 31 APR,JUN *", wantField: `day of month field "31"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dte.ParseCron(tt.expression)
+			if !errors.Is(err, dte.ErrCronParse) || !strings.Contains(err.Error(), tt.wantField) {
+				t.Errorf("ParseCron() error = %v, want %v naming %s", err, dte.ErrCronParse, tt.wantField)
+			}
+		})
+	}
+
+	// Either day field matching makes February 30th possible on Mondays.
+	_, err := dte.ParseCron("0 0 30 2 MON")
+	if err != nil {
+		t.Errorf("ParseCron() error = %v", err)
+	}
+}
+
+func TestCronJSON(t *testing.T) {
+	t.Parallel()
+
+	var cron dte.Cron
+
+	marshaled, err := json.Marshal(cron)
+	if err != nil || string(marshaled) != "null" {
+		t.Fatalf("Marshal() = %s, %v, want null", marshaled, err)
+	}
+
+	for _, input := range []string{`5`, `"* * *"`} {
+		err := json.Unmarshal([]byte(input), &cron)
+		if !errors.Is(err, dte.ErrCronParse) {
+			t.Errorf("Unmarshal(%s) error = %v, want %v", input, err, dte.ErrCronParse)
+		}
+	}
+
+	if next, ok := cron.Next(time.Now(), time.UTC); ok {
+		t.Errorf("Next() of the zero Cron = %v, want none", next)
+	}
+
+	err = json.Unmarshal([]byte(`"  0  9 * *   1-5 "`), &cron)
+	if err != nil || cron.String() != "0 9 * * 1-5" {
+		t.Errorf("Unmarshal() = %s, %v, want 0 9 * * 1-5", cron, err)
+	}
+}
+
+func assertCronOccurrences(t *testing.T, expression string, after string, location *time.Location, want []string) {
+	t.Helper()
+
+	cron, err := dte.ParseCron(expression)
+	if err != nil {
+		t.Fatalf("ParseCron() error = %v", err)
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, after)
+	if err != nil {
+		t.Fatalf("time.Parse() error = %v", err)
+	}
+
+	var got []string
+
+	for occurrence := range cron.Occurrences(start, location) {
+		if got = append(got, occurrence.Format(time.RFC3339)); len(got) == len(want) {
+			break
+		}
+	}
+
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("Occurrences() = %v, want %v", got, want)
+	}
+}
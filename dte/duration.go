@@ -0,0 +1,249 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrDurationParse = errors.New("duration is not an ISO 8601 duration, a Go duration or whole seconds")
+
+// DurationFormat is the string form a Duration is written in.
+type DurationFormat int
+
+const (
+	// DurationISO8601 writes a duration as an ISO 8601 duration such as PT1H30M. Hours are not carried into days
+	// since a day is not always 24 hours long.
+	DurationISO8601 DurationFormat = iota
+	// DurationGo writes a duration as time.Duration.String does, such as 1h30m0s.
+	DurationGo
+)
+
+// Duration is a time.Duration that is written as a string in JSON and text instead of an integer of nanoseconds.
+// Format picks the string form, the ISO 8601 form by default, and decoding accepts both forms as well as whole
+// seconds as a JSON number.
+type Duration struct { //nolint:recvcheck
+	time.Duration `example:"PT1H30M" format:"duration"`
+
+	Format DurationFormat
+}
+
+// NewDuration parses an ISO 8601 duration such as PT1H30M or a Go duration such as 1h30m. The ISO 8601 form may have
+// days and weeks, taken as 24 hours and 7 days, but not years and months, which have no fixed length and belong in
+// a Period. The Format of the result matches the parsed form.
+func NewDuration(s string) (Duration, error) {
+	trimmed := strings.TrimLeft(s, "+-")
+	if strings.HasPrefix(trimmed, "P") {
+		duration, err := parseISODuration(s)
+		if err != nil {
+			return Duration{}, err
+		}
+
+		return Duration{Duration: duration, Format: DurationISO8601}, nil
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return Duration{}, fmt.Errorf("%w: %w", ErrDurationParse, err)
+	}
+
+	return Duration{Duration: duration, Format: DurationGo}, nil
+}
+
+// String returns the duration in its Format.
+func (d Duration) String() string {
+	if d.Format == DurationGo {
+		return d.Duration.String()
+	}
+
+	return formatISODuration(d.Duration)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The duration is a quoted string in its Format.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The duration must be a quoted ISO 8601 or Go duration, or a JSON number of whole seconds. The Format of d is kept
+// so a decoded value is written back the same way. null leaves it unchanged.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		seconds, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil || seconds > math.MaxInt64/int64(time.Second) || seconds < math.MinInt64/int64(time.Second) {
+			return fmt.Errorf("Duration.UnmarshalJSON: %w: %s", ErrDurationParse, data)
+		}
+
+		d.Duration = time.Duration(seconds) * time.Second
+
+		return nil
+	}
+
+	return d.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. The Format of d is kept.
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := NewDuration(string(data))
+	if err != nil {
+		return err
+	}
+
+	d.Duration = parsed.Duration
+
+	return nil
+}
+
+// formatISODuration returns the ISO 8601 form of a duration in hours, minutes and seconds.
+func formatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+
+	// The magnitude of math.MinInt64 does not fit a time.Duration, so the components are taken as negative numbers.
+	if d < 0 {
+		b.WriteString("-")
+	} else {
+		d = -d
+	}
+
+	b.WriteString("PT")
+
+	if hours := d / time.Hour; hours != 0 {
+		b.WriteString(strconv.FormatInt(-int64(hours), 10) + "H")
+	}
+
+	if minutes := d % time.Hour / time.Minute; minutes != 0 {
+		b.WriteString(strconv.FormatInt(-int64(minutes), 10) + "M")
+	}
+
+	if seconds := d % time.Minute; seconds != 0 {
+		text := strconv.FormatInt(-int64(seconds/time.Second), 10)
+		if fraction := -seconds % time.Second; fraction != 0 {
+			text += strings.TrimRight(fmt.Sprintf(".%09d", fraction), "0")
+		}
+
+		b.WriteString(text + "S")
+	}
+
+	return b.String()
+}
+
+// parseISODuration parses an ISO 8601 duration with weeks, days, hours, minutes and seconds, where the last
+// component may have a decimal fraction.
+func parseISODuration(s string) (time.Duration, error) {
+	text, negative := strings.CutPrefix(s, "-")
+	if !negative {
+		text = strings.TrimPrefix(text, "+")
+	}
+
+	text, _ = strings.CutPrefix(text, "P")
+	datePart, timePart, hasTime := strings.Cut(text, "T")
+
+	if datePart == "" && timePart == "" || hasTime && timePart == "" {
+		return 0, fmt.Errorf("%w: %q", ErrDurationParse, s)
+	}
+
+	if strings.ContainsAny(datePart, "YM") {
+		return 0, fmt.Errorf("%w: %q has years or months, which need a Period", ErrDurationParse, s)
+	}
+
+	day := secondsPerDay * time.Second
+	dateUnits := []isoDurationUnit{{designator: 'W', length: daysPerWeek * day}, {designator: 'D', length: day}}
+	timeUnits := []isoDurationUnit{
+		{designator: 'H', length: time.Hour},
+		{designator: 'M', length: time.Minute},
+		{designator: 'S', length: time.Second},
+	}
+
+	var total time.Duration
+
+	for _, part := range []struct {
+		text  string
+		units []isoDurationUnit
+	}{{text: datePart, units: dateUnits}, {text: timePart, units: timeUnits}} {
+		duration, err := sumISODurationUnits(part.text, part.units)
+		if err != nil {
+			return 0, fmt.Errorf("%q: %w", s, err)
+		}
+
+		if duration > math.MaxInt64-total {
+			return 0, fmt.Errorf("%w: %q is too long", ErrDurationParse, s)
+		}
+
+		total += duration
+	}
+
+	if negative {
+		total = -total
+	}
+
+	return total, nil
+}
+
+// isoDurationUnit is a designator of an ISO 8601 duration and its length.
+type isoDurationUnit struct {
+	designator byte
+	length     time.Duration
+}
+
+// sumISODurationUnits returns the sum of the numbers before each designator of the units, which must appear in
+// order.
+func sumISODurationUnits(text string, units []isoDurationUnit) (time.Duration, error) {
+	var total time.Duration
+
+	for _, unit := range units {
+		i := strings.IndexByte(text, unit.designator)
+		if i < 0 {
+			continue
+		}
+
+		whole, fraction, hasFraction := strings.Cut(strings.ReplaceAll(text[:i], ",", "."), ".")
+		text = text[i+1:]
+
+		value, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || whole == "" || whole[0] == '-' || whole[0] == '+' {
+			return 0, fmt.Errorf("%w: %q is not a number", ErrDurationParse, whole)
+		}
+
+		if value > int64((math.MaxInt64-total)/unit.length) {
+			return 0, fmt.Errorf("%w: %d%c is too long", ErrDurationParse, value, unit.designator)
+		}
+
+		total += time.Duration(value) * unit.length
+
+		if hasFraction {
+			if text != "" {
+				return 0, fmt.Errorf("%w: only the last component may have a fraction", ErrDurationParse)
+			}
+
+			part, err := isoFraction(fraction, unit.length)
+			if err != nil || part > math.MaxInt64-total {
+				return 0, fmt.Errorf("%w: %q is not a decimal fraction", ErrDurationParse, fraction)
+			}
+
+			total += part
+		}
+	}
+
+	if text != "" {
+		return 0, fmt.Errorf("%w: %q is left over", ErrDurationParse, text)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,153 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleDuration() {
+	type Job struct {
+		Timeout dte.Duration `json:"timeout"`
+		Backoff dte.Duration `json:"backoff"`
+	}
+
+	job := Job{
+		Timeout: dte.Duration{Duration: 90 * time.Minute, Format: dte.DurationISO8601},
+		Backoff: dte.Duration{Duration: 1500 * time.Millisecond, Format: dte.DurationGo},
+	}
+
+	marshaled, err := json.Marshal(job)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled))
+
+	err = json.Unmarshal([]byte(`{"timeout":"1h30m","backoff":30}`), &job)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(job.Timeout, job.Backoff)
+
+	// Output:
+	// {"timeout":"PT1H30M","backoff":"1.5s"}
+	// PT1H30M 30s
+}
+
+func TestNewDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input      string
+		want       time.Duration
+		wantFormat dte.DurationFormat
+		wantErr    error
+	}{
+		{input: "PT1H30M", want: 90 * time.Minute},
+		{input: "PT0.5S", want: 500 * time.Millisecond},
+		{input: "PT1,5S", want: 1500 * time.Millisecond},
+		{input: "PT1.5H", want: 90 * time.Minute},
+		{input: "P1DT2H", want: 26 * time.Hour},
+		{input: "P2W", want: 14 * 24 * time.Hour},
+		{input: "-PT15M", want: -15 * time.Minute},
+		{input: "PT0S", want: 0},
+		{input: "1h30m", want: 90 * time.Minute, wantFormat: dte.DurationGo},
+		{input: "-1.5s", want: -1500 * time.Millisecond, wantFormat: dte.DurationGo},
+		{input: "P1M", wantErr: dte.ErrDurationParse},
+		{input: "P1Y", wantErr: dte.ErrDurationParse},
+		{input: "PT", wantErr: dte.ErrDurationParse},
+		{input: "P", wantErr: dte.ErrDurationParse},
+		{input: "PT1M1H", wantErr: dte.ErrDurationParse},
+		{input: "PT1.5H30M", wantErr: dte.ErrDurationParse},
+		{input: "PT-1H", wantErr: dte.ErrDurationParse},
+		{input: "PT3000000H", wantErr: dte.ErrDurationParse},
+		{input: "90", wantErr: dte.ErrDurationParse},
+		{input: "", wantErr: dte.ErrDurationParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.NewDuration(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewDuration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Duration != tt.want || got.Format != tt.wantFormat {
+				t.Errorf("NewDuration() = %v in format %d, want %v in format %d", got, got.Format, tt.want, tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestDurationString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		duration time.Duration
+		wantISO  string
+		wantGo   string
+	}{
+		{duration: 0, wantISO: "PT0S", wantGo: "0s"},
+		{duration: 90 * time.Minute, wantISO: "PT1H30M", wantGo: "1h30m0s"},
+		{duration: 26*time.Hour + time.Second, wantISO: "PT26H1S", wantGo: "26h0m1s"},
+		{duration: -1500 * time.Millisecond, wantISO: "-PT1.5S", wantGo: "-1.5s"},
+		{duration: time.Nanosecond, wantISO: "PT0.000000001S", wantGo: "1ns"},
+		{duration: -math.MaxInt64, wantISO: "-PT2562047H47M16.854775807S", wantGo: "-2562047h47m16.854775807s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantISO, func(t *testing.T) {
+			t.Parallel()
+
+			iso := dte.Duration{Duration: tt.duration, Format: dte.DurationISO8601}
+			goForm := dte.Duration{Duration: tt.duration, Format: dte.DurationGo}
+
+			if iso.String() != tt.wantISO || goForm.String() != tt.wantGo {
+				t.Fatalf("String() = %v and %v, want %v and %v", iso, goForm, tt.wantISO, tt.wantGo)
+			}
+
+			parsed, err := dte.NewDuration(iso.String())
+			if err != nil || parsed != iso {
+				t.Errorf("NewDuration(String()) = %v, %v, want %v", parsed, err, iso)
+			}
+		})
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	t.Parallel()
+
+	backoff := dte.Duration{Duration: 0, Format: dte.DurationGo}
+
+	err := json.Unmarshal([]byte(`"PT2M"`), &backoff)
+	if err != nil || backoff.String() != "2m0s" {
+		t.Fatalf("Unmarshal() = %v, %v, want 2m0s", backoff, err)
+	}
+
+	err = json.Unmarshal([]byte(`-45`), &backoff)
+	if err != nil || backoff.Duration != -45*time.Second {
+		t.Fatalf("Unmarshal() = %v, %v, want -45s", backoff, err)
+	}
+
+	for _, input := range []string{`1.5`, `true`, `99999999999`, `"soon"`} {
+		var duration dte.Duration
+
+		err := json.Unmarshal([]byte(input), &duration)
+		if !errors.Is(err, dte.ErrDurationParse) {
+			t.Errorf("Unmarshal(%s) error = %v, want %v", input, err, dte.ErrDurationParse)
+		}
+	}
+}
@@ -0,0 +1,894 @@
+package dte
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEDTFParse     = errors.New("value does not follow the extended date time format")
+	ErrEDTFUnbounded = errors.New("extended date time format value has an open or unknown end")
+)
+
+// EDTFKind is the kind of value an EDTF holds.
+type EDTFKind int
+
+const (
+	// EDTFKindDate is a single date such as 1984?, 2004-06~ or 201X.
+	EDTFKindDate EDTFKind = iota
+	// EDTFKindInterval is an interval such as 1950/1960 or 1985-04-12/.. whose ends may be open or unknown.
+	EDTFKindInterval
+	// EDTFKindOneOf is a set where exactly one member is true, such as [1667,1668,1670..1672].
+	EDTFKindOneOf
+	// EDTFKindAllOf is a set where every member is true, such as {1667,1668}.
+	EDTFKindAllOf
+)
+
+// EDTFBound describes an end of an EDTF interval or set.
+type EDTFBound int
+
+const (
+	// EDTFBoundDate is an end given by a date.
+	EDTFBoundDate EDTFBound = iota
+	// EDTFBoundOpen is an open end, written as .. in intervals and sets.
+	EDTFBoundOpen
+	// EDTFBoundUnknown is an unknown interval end, written as an empty string.
+	EDTFBoundUnknown
+)
+
+// EDTFPrecision is the finest component an EDTFDate specifies. It also names the components in
+// EDTFDate.Qualification.
+type EDTFPrecision int
+
+const (
+	EDTFPrecisionYear EDTFPrecision = iota
+	// EDTFPrecisionSeason is a month component of 21 to 41, a season, quarter, quadrimester or semestral.
+	EDTFPrecisionSeason
+	EDTFPrecisionMonth
+	EDTFPrecisionDay
+)
+
+// edtfQualifier is the uncertainty and approximation of a date component.
+type edtfQualifier uint8
+
+const (
+	edtfUncertain edtfQualifier = 1 << iota
+	edtfApproximate
+)
+
+const (
+	edtfYearWidth     = 4
+	edtfPartWidth     = 2
+	edtfMaxYear       = 999_999_999
+	edtfMaxExponent   = 9
+	edtfFirstSeason   = 21
+	edtfLastSeason    = 41
+	edtfMaxDay        = 31
+	edtfOpen          = ".."
+	edtfSetSeparator  = ","
+	edtfRangeSplitter = ".."
+)
+
+// edtfSeasonStarts and edtfSeasonLengths are the first month and the number of months of every month component from
+// 21 to 41: seasons, northern and southern hemisphere seasons, quarters, quadrimesters and semestrals.
+var ( //nolint:gochecknoglobals
+	edtfSeasonStarts  = []int{3, 6, 9, 12, 3, 6, 9, 12, 9, 12, 3, 6, 1, 4, 7, 10, 1, 5, 9, 1, 7}
+	edtfSeasonLengths = []int{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 4, 4, 6, 6}
+)
+
+// edtfComponent is the year, month or day of an EDTFDate.
+type edtfComponent struct {
+	// digits are the written digits with X for unspecified ones, empty when the component is absent.
+	digits    string
+	qualifier edtfQualifier
+}
+
+// EDTFDate is a single date of the Extended Date/Time Format, ISO 8601-2, with its precision and the uncertainty,
+// approximation and unspecified digits of each of its components.
+type EDTFDate struct {
+	year        edtfComponent
+	month       edtfComponent
+	day         edtfComponent
+	negative    bool
+	long        bool
+	exponent    int
+	significant int
+	clock       string
+}
+
+// EDTF is a value of the Extended Date/Time Format, ISO 8601-2, levels 0 to 2: a date, an interval or a set.
+// The zero value is empty and encodes as JSON null.
+type EDTF struct { //nolint:recvcheck
+	kind       EDTFKind
+	start      EDTFDate
+	end        EDTFDate
+	startBound EDTFBound
+	endBound   EDTFBound
+	members    []EDTF
+}
+
+// ParseEDTF parses an EDTF level 0, 1 or 2 string.
+func ParseEDTF(s string) (EDTF, error) {
+	var (
+		parsed EDTF
+		err    error
+	)
+
+	switch {
+	case strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]"):
+		parsed, err = parseEDTFSet(s[1:len(s)-1], EDTFKindOneOf)
+	case strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}"):
+		parsed, err = parseEDTFSet(s[1:len(s)-1], EDTFKindAllOf)
+	case strings.Contains(s, "/"):
+		parsed, err = parseEDTFInterval(s)
+	default:
+		var date EDTFDate
+
+		date, err = parseEDTFDate(s)
+		parsed = EDTF{kind: EDTFKindDate, start: date, end: date}
+	}
+
+	if err != nil {
+		return EDTF{}, fmt.Errorf("ParseEDTF %q: %w", s, err)
+	}
+
+	return parsed, nil
+}
+
+// Kind returns whether the value is a date, an interval or a set.
+func (e EDTF) Kind() EDTFKind {
+	return e.kind
+}
+
+// IsZero reports whether the value is the empty zero value.
+func (e EDTF) IsZero() bool {
+	return e.kind == EDTFKindDate && e.start.year.digits == ""
+}
+
+// Start returns the start of an interval, the date of a single date, or the first member date of a set.
+// The bound tells whether the start is that date, open or unknown.
+func (e EDTF) Start() (EDTFDate, EDTFBound) {
+	return e.start, e.startBound
+}
+
+// End returns the end of an interval, the date of a single date, or the last member date of a set.
+// The bound tells whether the end is that date, open or unknown.
+func (e EDTF) End() (EDTFDate, EDTFBound) {
+	return e.end, e.endBound
+}
+
+// Members returns the members of a set, each a date or an interval for a range such as 1670..1672.
+func (e EDTF) Members() []EDTF {
+	return e.members
+}
+
+// Earliest returns the earliest date the value may refer to, for example 2010-01-01 for 201X and 1950-01-01 for
+// 1950/1960. Open and unknown starts, and the zero value, return ErrEDTFUnbounded.
+func (e EDTF) Earliest() (Date, error) {
+	if e.startBound != EDTFBoundDate || e.IsZero() {
+		return Date{}, ErrEDTFUnbounded
+	}
+
+	if e.kind == EDTFKindOneOf || e.kind == EDTFKindAllOf {
+		var earliest Date
+
+		for i, member := range e.members {
+			memberEarliest, err := member.Earliest()
+			if err != nil {
+				return Date{}, err
+			}
+
+			if i == 0 || memberEarliest.Before(earliest.Time) {
+				earliest = memberEarliest
+			}
+		}
+
+		return earliest, nil
+	}
+
+	return e.start.Earliest()
+}
+
+// Latest returns the latest date the value may refer to, for example 2019-12-31 for 201X and 1960-12-31 for
+// 1950/1960. Open and unknown ends, and the zero value, return ErrEDTFUnbounded.
+func (e EDTF) Latest() (Date, error) {
+	if e.endBound != EDTFBoundDate || e.IsZero() {
+		return Date{}, ErrEDTFUnbounded
+	}
+
+	if e.kind == EDTFKindOneOf || e.kind == EDTFKindAllOf {
+		var latest Date
+
+		for i, member := range e.members {
+			memberLatest, err := member.Latest()
+			if err != nil {
+				return Date{}, err
+			}
+
+			if i == 0 || memberLatest.After(latest.Time) {
+				latest = memberLatest
+			}
+		}
+
+		return latest, nil
+	}
+
+	return e.end.Latest()
+}
+
+// String returns the canonical EDTF form of the value.
+func (e EDTF) String() string {
+	switch e.kind {
+	case EDTFKindInterval:
+		return edtfBoundString(e.start, e.startBound) + "/" + edtfBoundString(e.end, e.endBound)
+	case EDTFKindOneOf, EDTFKindAllOf:
+		members := make([]string, len(e.members))
+
+		for i, member := range e.members {
+			members[i] = member.String()
+			if member.kind == EDTFKindInterval {
+				members[i] = member.start.String() + edtfRangeSplitter + member.end.String()
+			}
+		}
+
+		if e.startBound == EDTFBoundOpen {
+			members[0] = edtfOpen + members[0]
+		}
+
+		if e.endBound == EDTFBoundOpen {
+			members[len(members)-1] += edtfOpen
+		}
+
+		if e.kind == EDTFKindAllOf {
+			return "{" + strings.Join(members, edtfSetSeparator) + "}"
+		}
+
+		return "[" + strings.Join(members, edtfSetSeparator) + "]"
+	default:
+		return e.start.String()
+	}
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The value is a quoted canonical EDTF string, or null for the zero value.
+func (e EDTF) MarshalJSON() ([]byte, error) {
+	if e.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(e.String()) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The value must be a quoted EDTF string or null.
+func (e *EDTF) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == "\"null\"" {
+		return nil
+	}
+
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return fmt.Errorf("EDTF.UnmarshalJSON: input is not a JSON string: %w", err)
+	}
+
+	return e.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (e EDTF) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (e *EDTF) UnmarshalText(data []byte) error {
+	parsed, err := ParseEDTF(string(data))
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+
+	return nil
+}
+
+// Precision returns the finest component the date specifies.
+func (d EDTFDate) Precision() EDTFPrecision {
+	switch {
+	case d.day.digits != "":
+		return EDTFPrecisionDay
+	case d.isSeason():
+		return EDTFPrecisionSeason
+	case d.month.digits != "":
+		return EDTFPrecisionMonth
+	default:
+		return EDTFPrecisionYear
+	}
+}
+
+// Uncertain reports whether any component of the date is uncertain, marked with ? or %.
+func (d EDTFDate) Uncertain() bool {
+	return (d.year.qualifier|d.month.qualifier|d.day.qualifier)&edtfUncertain != 0
+}
+
+// Approximate reports whether any component of the date is approximate, marked with ~ or %.
+func (d EDTFDate) Approximate() bool {
+	return (d.year.qualifier|d.month.qualifier|d.day.qualifier)&edtfApproximate != 0
+}
+
+// Unspecified reports whether any digit of the date is unspecified, written as X.
+func (d EDTFDate) Unspecified() bool {
+	return strings.Contains(d.year.digits+d.month.digits+d.day.digits, "X")
+}
+
+// Qualification returns whether the year, month or day component, selected by its precision, is uncertain and
+// whether it is approximate.
+func (d EDTFDate) Qualification(component EDTFPrecision) (bool, bool) {
+	var qualifier edtfQualifier
+
+	switch component {
+	case EDTFPrecisionYear:
+		qualifier = d.year.qualifier
+	case EDTFPrecisionSeason, EDTFPrecisionMonth:
+		qualifier = d.month.qualifier
+	case EDTFPrecisionDay:
+		qualifier = d.day.qualifier
+	}
+
+	return qualifier&edtfUncertain != 0, qualifier&edtfApproximate != 0
+}
+
+// Earliest returns the earliest day the date may refer to.
+func (d EDTFDate) Earliest() (Date, error) {
+	return d.bound(false)
+}
+
+// Latest returns the latest day the date may refer to.
+func (d EDTFDate) Latest() (Date, error) {
+	return d.bound(true)
+}
+
+// String returns the canonical EDTF form of the date. A qualifier shared by the year and every following qualified
+// component is written once after the last of them, any other qualification before each component.
+func (d EDTFDate) String() string {
+	components := []edtfComponent{d.year, d.month, d.day}
+	if d.day.digits == "" {
+		components = components[:2]
+	}
+
+	if d.month.digits == "" {
+		components = components[:1]
+	}
+
+	parts := make([]string, len(components))
+	for i, component := range components {
+		parts[i] = component.digits
+	}
+
+	parts[0] = d.yearString()
+
+	last := -1
+
+	for i, component := range components {
+		if component.qualifier != 0 {
+			last = i
+		}
+	}
+
+	if last >= 0 {
+		grouped := true
+
+		for i := range last {
+			grouped = grouped && components[i].qualifier == components[last].qualifier
+		}
+
+		if grouped {
+			parts[last] += edtfQualifierString(components[last].qualifier)
+		} else {
+			for i, component := range components {
+				parts[i] = edtfQualifierString(component.qualifier) + parts[i]
+			}
+		}
+	}
+
+	formatted := strings.Join(parts, "-")
+	if d.clock != "" {
+		formatted += "T" + d.clock
+	}
+
+	return formatted
+}
+
+func (d EDTFDate) yearString() string {
+	var builder strings.Builder
+
+	if d.long {
+		builder.WriteString("Y")
+	}
+
+	if d.negative {
+		builder.WriteString("-")
+	}
+
+	builder.WriteString(d.year.digits)
+
+	if d.exponent > 0 {
+		builder.WriteString("E" + strconv.Itoa(d.exponent))
+	}
+
+	if d.significant > 0 {
+		builder.WriteString("S" + strconv.Itoa(d.significant))
+	}
+
+	return builder.String()
+}
+
+func (d EDTFDate) isSeason() bool {
+	month, err := strconv.Atoi(d.month.digits)
+
+	return err == nil && month >= edtfFirstSeason
+}
+
+// yearRange returns the first and last year the year component allows. Years with unspecified digits also have to
+// match them, see yearMatches.
+func (d EDTFDate) yearRange() (int, int) {
+	low, _ := strconv.Atoi(strings.ReplaceAll(d.year.digits, "X", "0"))
+	high, _ := strconv.Atoi(strings.ReplaceAll(d.year.digits, "X", "9"))
+
+	for range d.exponent {
+		low, high = low*isoDigitBase, high*isoDigitBase
+	}
+
+	if d.significant > 0 {
+		step := 1
+		for range len(strconv.Itoa(low)) - d.significant {
+			step *= isoDigitBase
+		}
+
+		low -= low % step
+		high = low + step - 1
+	}
+
+	if d.negative {
+		return -high, -low
+	}
+
+	return low, high
+}
+
+func (d EDTFDate) yearMatches(year int) bool {
+	if !strings.Contains(d.year.digits, "X") {
+		return true
+	}
+
+	return edtfDigitsMatch(d.year.digits, fmt.Sprintf("%04d", year))
+}
+
+// bound returns the earliest or the latest day the date allows.
+func (d EDTFDate) bound(latest bool) (Date, error) {
+	low, high := d.yearRange()
+
+	year, step := low, 1
+	if latest {
+		year, step = high, -1
+	}
+
+	for ; year >= low && year <= high; year += step {
+		if !d.yearMatches(year) {
+			continue
+		}
+
+		if d.isSeason() {
+			return d.seasonBound(year, latest), nil
+		}
+
+		for _, month := range edtfCandidates(d.month.digits, int(time.December), latest) {
+			for _, day := range edtfCandidates(d.day.digits, edtfMaxDay, latest) {
+				candidate := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+				if candidate.Day() == day {
+					return Date{candidate}, nil
+				}
+			}
+		}
+	}
+
+	return Date{}, fmt.Errorf("%w: %s matches no day", ErrEDTFParse, d)
+}
+
+func (d EDTFDate) seasonBound(year int, latest bool) Date {
+	season, _ := strconv.Atoi(d.month.digits)
+	start := edtfSeasonStarts[season-edtfFirstSeason]
+
+	if !latest {
+		return Date{time.Date(year, time.Month(start), 1, 0, 0, 0, 0, time.UTC)}
+	}
+
+	// The day before the first day of the month after the season, which may be in the next year.
+	end := time.Date(year, time.Month(start+edtfSeasonLengths[season-edtfFirstSeason]), 0, 0, 0, 0, 0, time.UTC)
+
+	return Date{end}
+}
+
+// edtfCandidates returns the values from 1 to maximum matching the digits of a month or day component in ascending
+// order, or in descending order for latest. An absent component matches every value.
+func edtfCandidates(digits string, maximum int, latest bool) []int {
+	candidates := make([]int, 0, maximum)
+
+	for value := 1; value <= maximum; value++ {
+		if digits == "" || edtfDigitsMatch(digits, fmt.Sprintf("%02d", value)) {
+			candidates = append(candidates, value)
+		}
+	}
+
+	if latest {
+		for i, j := 0, len(candidates)-1; i < j; i, j = i+1, j-1 {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		}
+	}
+
+	return candidates
+}
+
+// edtfDigitsMatch reports whether value matches digits, where X matches any digit.
+func edtfDigitsMatch(digits string, value string) bool {
+	if len(digits) != len(value) {
+		return false
+	}
+
+	for i := range len(digits) {
+		if digits[i] != 'X' && digits[i] != value[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func edtfQualifierString(qualifier edtfQualifier) string {
+	switch qualifier {
+	case edtfUncertain:
+		return "?"
+	case edtfApproximate:
+		return "~"
+	case edtfUncertain | edtfApproximate:
+		return "%"
+	default:
+		return ""
+	}
+}
+
+func edtfBoundString(date EDTFDate, bound EDTFBound) string {
+	switch bound {
+	case EDTFBoundOpen:
+		return edtfOpen
+	case EDTFBoundUnknown:
+		return ""
+	default:
+		return date.String()
+	}
+}
+
+func parseEDTFInterval(s string) (EDTF, error) {
+	startText, endText, _ := strings.Cut(s, "/")
+
+	interval := EDTF{kind: EDTFKindInterval}
+
+	var err error
+
+	interval.start, interval.startBound, err = parseEDTFBound(startText)
+	if err != nil {
+		return EDTF{}, err
+	}
+
+	interval.end, interval.endBound, err = parseEDTFBound(endText)
+	if err != nil {
+		return EDTF{}, err
+	}
+
+	if interval.startBound != EDTFBoundDate && interval.endBound != EDTFBoundDate {
+		return EDTF{}, fmt.Errorf("%w: interval has no date", ErrEDTFParse)
+	}
+
+	return interval, checkEDTFOrder(interval)
+}
+
+func parseEDTFBound(s string) (EDTFDate, EDTFBound, error) {
+	switch s {
+	case edtfOpen:
+		return EDTFDate{}, EDTFBoundOpen, nil
+	case "":
+		return EDTFDate{}, EDTFBoundUnknown, nil
+	}
+
+	date, err := parseEDTFDate(s)
+
+	return date, EDTFBoundDate, err
+}
+
+func parseEDTFSet(s string, kind EDTFKind) (EDTF, error) {
+	set := EDTF{kind: kind}
+
+	if s == "" {
+		return EDTF{}, fmt.Errorf("%w: empty set", ErrEDTFParse)
+	}
+
+	elements := strings.Split(s, edtfSetSeparator)
+
+	if trimmed, ok := strings.CutPrefix(elements[0], edtfOpen); ok {
+		elements[0], set.startBound = trimmed, EDTFBoundOpen
+	}
+
+	if trimmed, ok := strings.CutSuffix(elements[len(elements)-1], edtfOpen); ok {
+		elements[len(elements)-1], set.endBound = trimmed, EDTFBoundOpen
+	}
+
+	for _, element := range elements {
+		member, err := parseEDTFSetMember(element)
+		if err != nil {
+			return EDTF{}, err
+		}
+
+		set.members = append(set.members, member)
+	}
+
+	set.start = set.members[0].start
+	set.end = set.members[len(set.members)-1].end
+
+	return set, nil
+}
+
+func parseEDTFSetMember(s string) (EDTF, error) {
+	startText, endText, isRange := strings.Cut(s, edtfRangeSplitter)
+
+	start, err := parseEDTFDate(startText)
+	if err != nil {
+		return EDTF{}, err
+	}
+
+	if !isRange {
+		return EDTF{kind: EDTFKindDate, start: start, end: start}, nil
+	}
+
+	end, err := parseEDTFDate(endText)
+	if err != nil {
+		return EDTF{}, err
+	}
+
+	member := EDTF{kind: EDTFKindInterval, start: start, end: end}
+
+	return member, checkEDTFOrder(member)
+}
+
+// checkEDTFOrder returns an error when an interval with two dates ends before it may start.
+func checkEDTFOrder(interval EDTF) error {
+	if interval.startBound != EDTFBoundDate || interval.endBound != EDTFBoundDate {
+		return nil
+	}
+
+	earliest, err := interval.start.Earliest()
+	if err != nil {
+		return err
+	}
+
+	latest, err := interval.end.Latest()
+	if err != nil {
+		return err
+	}
+
+	if latest.Before(earliest.Time) {
+		return fmt.Errorf("%w: %s ends before %s starts", ErrEDTFParse, interval.end, interval.start)
+	}
+
+	return nil
+}
+
+// parseEDTFDate parses a single date with optional qualifiers, or a level 0 date and time.
+func parseEDTFDate(s string) (EDTFDate, error) {
+	datePart, clock, hasClock := strings.Cut(s, "T")
+	if hasClock {
+		return parseEDTFDateTime(datePart, clock)
+	}
+
+	scanner := edtfScanner{s: s}
+
+	var date EDTFDate
+
+	leading := scanner.qualifier()
+
+	err := scanner.year(&date)
+	if err != nil {
+		return EDTFDate{}, err
+	}
+
+	date.year.qualifier |= leading
+	date.year.qualifier |= scanner.qualifier()
+
+	components := []*edtfComponent{&date.year}
+
+	for _, component := range []*edtfComponent{&date.month, &date.day} {
+		if scanner.done() {
+			break
+		}
+
+		if !scanner.consume('-') {
+			return EDTFDate{}, fmt.Errorf("%w: expected - at %d", ErrEDTFParse, scanner.pos)
+		}
+
+		component.qualifier = scanner.qualifier()
+
+		component.digits = scanner.digits(edtfPartWidth, true)
+		if len(component.digits) != edtfPartWidth {
+			return EDTFDate{}, fmt.Errorf("%w: expected two digits at %d", ErrEDTFParse, scanner.pos)
+		}
+
+		components = append(components, component)
+
+		trailing := scanner.qualifier()
+		for _, qualified := range components {
+			qualified.qualifier |= trailing
+		}
+	}
+
+	if !scanner.done() {
+		return EDTFDate{}, fmt.Errorf("%w: unexpected %q", ErrEDTFParse, s[scanner.pos:])
+	}
+
+	return date, date.validate()
+}
+
+func parseEDTFDateTime(datePart string, clock string) (EDTFDate, error) {
+	parsedDate, err := time.Parse(DateOnly, datePart)
+	if err != nil {
+		return EDTFDate{}, fmt.Errorf("%w: %w", ErrEDTFParse, err)
+	}
+
+	for _, layout := range []string{time.TimeOnly, TimeOnlyWithTimezone, TimeOnlyWithTimezoneShort} {
+		if _, err = time.Parse(layout, clock); err == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		return EDTFDate{}, fmt.Errorf("%w: %w", ErrEDTFParse, err)
+	}
+
+	return EDTFDate{
+		year:  edtfComponent{digits: parsedDate.Format("2006"), qualifier: 0},
+		month: edtfComponent{digits: parsedDate.Format("01"), qualifier: 0},
+		day:   edtfComponent{digits: parsedDate.Format("02"), qualifier: 0},
+		clock: clock,
+	}, nil
+}
+
+// validate checks the combinations the scanner can not, and that the date matches at least one day.
+func (d EDTFDate) validate() error {
+	hasUnspecified := strings.Contains(d.year.digits, "X")
+
+	switch {
+	case hasUnspecified && (d.negative || d.significant > 0):
+		return fmt.Errorf("%w: negative and significant digit years can not have unspecified digits", ErrEDTFParse)
+	case d.significant > len(strings.TrimLeft(d.year.digits, "0"))+d.exponent:
+		return fmt.Errorf("%w: more significant digits than the year has", ErrEDTFParse)
+	case d.exponent > edtfMaxExponent:
+		return fmt.Errorf("%w: exponent %d is too large", ErrEDTFParse, d.exponent)
+	}
+
+	if low, high := d.yearRange(); low < -edtfMaxYear || high > edtfMaxYear {
+		return fmt.Errorf("%w: year is outside ±%d", ErrEDTFParse, edtfMaxYear)
+	}
+
+	if d.month.digits != "" && !strings.Contains(d.month.digits, "X") {
+		month, _ := strconv.Atoi(d.month.digits)
+
+		validMonth := month >= 1 && month <= int(time.December)
+		validSeason := month >= edtfFirstSeason && month <= edtfLastSeason && d.day.digits == ""
+
+		if !validMonth && !validSeason {
+			return fmt.Errorf("%w: %s is not a month or a season", ErrEDTFParse, d.month.digits)
+		}
+	}
+
+	_, err := d.Earliest()
+
+	return err
+}
+
+// edtfScanner reads the parts of an EDTF date from left to right.
+type edtfScanner struct {
+	s   string
+	pos int
+}
+
+func (s *edtfScanner) done() bool {
+	return s.pos >= len(s.s)
+}
+
+func (s *edtfScanner) consume(b byte) bool {
+	if !s.done() && s.s[s.pos] == b {
+		s.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (s *edtfScanner) qualifier() edtfQualifier {
+	switch {
+	case s.consume('?'):
+		return edtfUncertain
+	case s.consume('~'):
+		return edtfApproximate
+	case s.consume('%'):
+		return edtfUncertain | edtfApproximate
+	default:
+		return 0
+	}
+}
+
+// digits reads up to limit digits, or any number of them for a limit of 0, also accepting X when unspecified is
+// set.
+func (s *edtfScanner) digits(limit int, unspecified bool) string {
+	start := s.pos
+
+	for !s.done() && (limit == 0 || s.pos-start < limit) {
+		b := s.s[s.pos]
+		if (b < '0' || b > '9') && (!unspecified || b != 'X') {
+			break
+		}
+
+		s.pos++
+	}
+
+	return s.s[start:s.pos]
+}
+
+// number reads a positive decimal number after the prefix, returning 0 when the prefix is absent.
+func (s *edtfScanner) number(prefix byte) (int, error) {
+	if !s.consume(prefix) {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(s.digits(0, false))
+	if err != nil || value < 1 {
+		return 0, fmt.Errorf("%w: expected a positive number after %c", ErrEDTFParse, prefix)
+	}
+
+	return value, nil
+}
+
+// year reads a four digit year, or a Y prefixed year with an optional exponent, and its significant digits.
+func (s *edtfScanner) year(date *EDTFDate) error {
+	date.long = s.consume('Y')
+	date.negative = s.consume('-')
+
+	if date.long {
+		date.year.digits = s.digits(0, false)
+		if date.year.digits == "" {
+			return fmt.Errorf("%w: expected year digits at %d", ErrEDTFParse, s.pos)
+		}
+
+		var err error
+
+		date.exponent, err = s.number('E')
+		if err != nil {
+			return err
+		}
+	} else {
+		date.year.digits = s.digits(edtfYearWidth, true)
+		if len(date.year.digits) != edtfYearWidth {
+			return fmt.Errorf("%w: expected four year digits at %d", ErrEDTFParse, s.pos)
+		}
+	}
+
+	var err error
+
+	date.significant, err = s.number('S')
+
+	return err
+}
@@ -0,0 +1,341 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseEDTF() {
+	for _, input := range []string{"1984?", "2004-06~", "201X", "1950/1960", "[1667,1668,1670..1672]"} {
+		parsed, err := dte.ParseEDTF(input)
+		if err != nil {
+			return
+		}
+
+		earliest, err := parsed.Earliest()
+		if err != nil {
+			return
+		}
+
+		latest, err := parsed.Latest()
+		if err != nil {
+			return
+		}
+
+		fmt.Println(parsed, earliest, latest)
+	}
+
+	// Output:
+	// 1984? 1984-01-01 1984-12-31
+	// 2004-06~ 2004-06-01 2004-06-30
+	// 201X 2010-01-01 2019-12-31
+	// 1950/1960 1950-01-01 1960-12-31
+	// [1667,1668,1670..1672] 1667-01-01 1672-12-31
+}
+
+func ExampleEDTF_json() {
+	type Item struct {
+		Created dte.EDTF `json:"created"`
+	}
+
+	var item Item
+
+	err := json.Unmarshal([]byte(`{"created":"2004?-06?-11"}`), &item)
+	if err != nil {
+		return
+	}
+
+	date, _ := item.Created.Start()
+	yearUncertain, _ := date.Qualification(dte.EDTFPrecisionYear)
+	dayUncertain, _ := date.Qualification(dte.EDTFPrecisionDay)
+
+	marshaled, err := json.Marshal(item)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled), yearUncertain, dayUncertain)
+
+	// Output: {"created":"2004-06?-11"} true false
+}
+
+func TestParseEDTF(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		wantString   string
+		wantEarliest string
+		wantLatest   string
+	}{
+		// Level 0.
+		{name: "day", input: "1985-04-12", wantString: "1985-04-12", wantEarliest: "1985-04-12", wantLatest: "1985-04-12"},
+		{name: "month", input: "2024-02", wantString: "2024-02", wantEarliest: "2024-02-01", wantLatest: "2024-02-29"},
+		{name: "year", input: "1985", wantString: "1985", wantEarliest: "1985-01-01", wantLatest: "1985-12-31"},
+		{
+			name:         "date and time",
+			input:        "1985-04-12T23:20:30+04:00",
+			wantString:   "1985-04-12T23:20:30+04:00",
+			wantEarliest: "1985-04-12",
+			wantLatest:   "1985-04-12",
+		},
+		{
+			name:         "interval",
+			input:        "1964/2008",
+			wantString:   "1964/2008",
+			wantEarliest: "1964-01-01",
+			wantLatest:   "2008-12-31",
+		},
+		// Level 1.
+		{name: "long year", input: "Y170000002", wantString: "Y170000002", wantEarliest: "", wantLatest: ""},
+		{name: "season", input: "2001-21", wantString: "2001-21", wantEarliest: "2001-03-01", wantLatest: "2001-05-31"},
+		{name: "winter", input: "2001-24", wantString: "2001-24", wantEarliest: "2001-12-01", wantLatest: "2002-02-28"},
+		{name: "uncertain", input: "1984?", wantString: "1984?", wantEarliest: "1984-01-01", wantLatest: "1984-12-31"},
+		{
+			name:         "both",
+			input:        "2004-06-11%",
+			wantString:   "2004-06-11%",
+			wantEarliest: "2004-06-11",
+			wantLatest:   "2004-06-11",
+		},
+		{name: "unspecified decade", input: "20XX", wantString: "20XX", wantEarliest: "2000-01-01", wantLatest: "2099-12-31"},
+		{
+			name:         "unspecified day",
+			input:        "2004-02-XX",
+			wantString:   "2004-02-XX",
+			wantEarliest: "2004-02-01",
+			wantLatest:   "2004-02-29",
+		},
+		{name: "negative year", input: "-1985", wantString: "-1985", wantEarliest: "", wantLatest: ""},
+		{name: "open end", input: "1985-04-12/..", wantString: "1985-04-12/..", wantEarliest: "1985-04-12", wantLatest: ""},
+		{name: "unknown start", input: "/1985-04", wantString: "/1985-04", wantEarliest: "", wantLatest: "1985-04-30"},
+		// Level 2.
+		{name: "exponent", input: "Y-17E7", wantString: "Y-17E7", wantEarliest: "", wantLatest: ""},
+		{
+			name:         "significant digits",
+			input:        "1950S2",
+			wantString:   "1950S2",
+			wantEarliest: "1900-01-01",
+			wantLatest:   "1999-12-31",
+		},
+		{name: "quarter", input: "2001-34", wantString: "2001-34", wantEarliest: "2001-04-01", wantLatest: "2001-06-30"},
+		{name: "semestral", input: "2001-41", wantString: "2001-41", wantEarliest: "2001-07-01", wantLatest: "2001-12-31"},
+		{
+			name:         "component qualification",
+			input:        "?2004-06-~11",
+			wantString:   "?2004-06-~11",
+			wantEarliest: "2004-06-11",
+			wantLatest:   "2004-06-11",
+		},
+		{
+			name:         "trailing qualification is grouped",
+			input:        "?2004-?06-11",
+			wantString:   "2004-06?-11",
+			wantEarliest: "2004-06-11",
+			wantLatest:   "2004-06-11",
+		},
+		{
+			name:         "unspecified digits anywhere",
+			input:        "156X-1X-2X",
+			wantString:   "156X-1X-2X",
+			wantEarliest: "1560-10-20",
+			wantLatest:   "1569-12-29",
+		},
+		{
+			name:         "one of set",
+			input:        "[..1760-12-03]",
+			wantString:   "[..1760-12-03]",
+			wantEarliest: "",
+			wantLatest:   "1760-12-03",
+		},
+		{
+			name:         "all of set",
+			input:        "{1960,1961-12}",
+			wantString:   "{1960,1961-12}",
+			wantEarliest: "1960-01-01",
+			wantLatest:   "1961-12-31",
+		},
+		{
+			name:         "set open at the end",
+			input:        "[1760-01,1760-02,1760-12..]",
+			wantString:   "[1760-01,1760-02,1760-12..]",
+			wantEarliest: "1760-01-01",
+			wantLatest:   "",
+		},
+		{
+			name:         "interval of qualified dates",
+			input:        "2004-06-~01/2004-06-~20",
+			wantString:   "2004-06-~01/2004-06-~20",
+			wantEarliest: "2004-06-01",
+			wantLatest:   "2004-06-20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseEDTF(tt.input)
+			if err != nil {
+				t.Fatalf("ParseEDTF() error = %v", err)
+			}
+
+			if got.String() != tt.wantString {
+				t.Errorf("String() = %v, want %v", got, tt.wantString)
+			}
+
+			checkEDTFBound(t, "Earliest", got.Earliest, tt.wantEarliest)
+			checkEDTFBound(t, "Latest", got.Latest, tt.wantLatest)
+
+			again, err := dte.ParseEDTF(got.String())
+			if err != nil || again.String() != got.String() {
+				t.Errorf("ParseEDTF(String()) = %v, %v, want %v", again, err, got)
+			}
+		})
+	}
+}
+
+// checkEDTFBound checks the result of Earliest or Latest, where an empty want expects ErrEDTFUnbounded for open and
+// unknown ends or just a date outside of the four digit years.
+func checkEDTFBound(t *testing.T, name string, bound func() (dte.Date, error), want string) {
+	t.Helper()
+
+	got, err := bound()
+	if want == "" {
+		if err != nil && !errors.Is(err, dte.ErrEDTFUnbounded) {
+			t.Errorf("%s() error = %v", name, err)
+		}
+
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("%s() error = %v", name, err)
+	}
+
+	if got.String() != want {
+		t.Errorf("%s() = %v, want %v", name, got, want)
+	}
+}
+
+func TestEDTFDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		input           string
+		wantPrecision   dte.EDTFPrecision
+		wantUncertain   bool
+		wantApproximate bool
+		wantUnspecified bool
+	}{
+		{name: "day", input: "2004-06-11", wantPrecision: dte.EDTFPrecisionDay},
+		{name: "approximate month", input: "2004-06~", wantPrecision: dte.EDTFPrecisionMonth, wantApproximate: true},
+		{name: "season", input: "2004-22?", wantPrecision: dte.EDTFPrecisionSeason, wantUncertain: true},
+		{name: "unspecified year", input: "19XX%", wantUncertain: true, wantApproximate: true, wantUnspecified: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := dte.ParseEDTF(tt.input)
+			if err != nil {
+				t.Fatalf("ParseEDTF() error = %v", err)
+			}
+
+			date, bound := parsed.Start()
+			if bound != dte.EDTFBoundDate || parsed.Kind() != dte.EDTFKindDate {
+				t.Fatalf("Start() bound = %v, kind = %v", bound, parsed.Kind())
+			}
+
+			if date.Precision() != tt.wantPrecision {
+				t.Errorf("Precision() = %v, want %v", date.Precision(), tt.wantPrecision)
+			}
+
+			if date.Uncertain() != tt.wantUncertain || date.Approximate() != tt.wantApproximate {
+				t.Errorf("Uncertain(), Approximate() = %v, %v", date.Uncertain(), date.Approximate())
+			}
+
+			if date.Unspecified() != tt.wantUnspecified {
+				t.Errorf("Unspecified() = %v, want %v", date.Unspecified(), tt.wantUnspecified)
+			}
+		})
+	}
+}
+
+func TestParseEDTFErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"",
+		"85",
+		"1985-13",
+		"1985-42",
+		"1985-21-01",
+		"2023-02-29",
+		"2004-02-3X",
+		"1985-04-12-01",
+		"1985?-",
+		"-19XX",
+		"1950S5",
+		"Y",
+		"Y1E10",
+		"../..",
+		"/",
+		"1960/1950",
+		"[]",
+		"[1672..1670]",
+		"1985-04-12T25:00:00",
+		"1985-04T10:00:00",
+	} {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dte.ParseEDTF(input)
+			if !errors.Is(err, dte.ErrEDTFParse) {
+				t.Errorf("ParseEDTF() error = %v, wantErr %v", err, dte.ErrEDTFParse)
+			}
+		})
+	}
+}
+
+func TestEDTFJSON(t *testing.T) {
+	t.Parallel()
+
+	type Item struct {
+		Created dte.EDTF  `json:"created"`
+		Empty   dte.EDTF  `json:"empty"`
+		Pointer *dte.EDTF `json:"pointer"`
+	}
+
+	var item Item
+
+	err := json.Unmarshal([]byte(`{"created":"1950/..","empty":null,"pointer":"{1667,1668}"}`), &item)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if item.Created.Kind() != dte.EDTFKindInterval || item.Pointer.Kind() != dte.EDTFKindAllOf || !item.Empty.IsZero() {
+		t.Errorf("Unmarshal() = %+v", item)
+	}
+
+	marshaled, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if want := `{"created":"1950/..","empty":null,"pointer":"{1667,1668}"}`; string(marshaled) != want {
+		t.Errorf("Marshal() = %s, want %s", marshaled, want)
+	}
+
+	err = json.Unmarshal([]byte(`{"created":"1950//"}`), &item)
+	if !errors.Is(err, dte.ErrEDTFParse) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, dte.ErrEDTFParse)
+	}
+}
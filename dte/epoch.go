@@ -0,0 +1,196 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrEpochRange = errors.New("day count is outside the 0001-01-01 to 9999-12-31 date range")
+
+const (
+	secondsPerDay      = 24 * 60 * 60
+	millisecondsPerDay = secondsPerDay * 1000
+	nanosecondsPerTick = 100
+	ticksPerDay        = secondsPerDay * int64(time.Second) / nanosecondsPerTick
+
+	// minUnixDays and maxUnixDays are 0001-01-01 and 9999-12-31 as days since the Unix epoch.
+	minUnixDays = -719162
+	maxUnixDays = 2932896
+
+	// julianDayUnixEpoch is the Julian Day Number of 1970-01-01.
+	julianDayUnixEpoch = 2440588
+	// modifiedJulianDayUnixEpoch is the Modified Julian Day of 1970-01-01, MJD 0 being 1858-11-17.
+	modifiedJulianDayUnixEpoch = 40587
+	// rataDieUnixEpoch is the Rata Die of 1970-01-01, RD 1 being 0001-01-01 of the proleptic Gregorian calendar.
+	rataDieUnixEpoch = 719163
+	// julianDateNoon is the offset of a Julian Date, which starts its days at noon, from the Julian Day Number of
+	// the same date.
+	julianDateNoon = 0.5
+)
+
+// DateToUnixDays returns the number of days from 1970-01-01 to the date.
+func DateToUnixDays(d Date) int64 {
+	return unixDays(d)
+}
+
+// DateFromUnixDays returns the date the given number of days after 1970-01-01.
+func DateFromUnixDays(days int64) (Date, error) {
+	if days < minUnixDays || days > maxUnixDays {
+		return Date{}, fmt.Errorf("%w: %d unix days", ErrEpochRange, days)
+	}
+
+	return dateFromUnixDays(days), nil
+}
+
+// DateToJulianDay returns the Julian Day Number of the date, the day count used in astronomy that starts at
+// 4714-11-24 BC of the proleptic Gregorian calendar.
+func DateToJulianDay(d Date) int64 {
+	return unixDays(d) + julianDayUnixEpoch
+}
+
+// DateFromJulianDay returns the date of a Julian Day Number.
+func DateFromJulianDay(jdn int64) (Date, error) {
+	days := jdn - julianDayUnixEpoch
+	if days < minUnixDays || days > maxUnixDays {
+		return Date{}, fmt.Errorf("%w: julian day %d", ErrEpochRange, jdn)
+	}
+
+	return dateFromUnixDays(days), nil
+}
+
+// DateToModifiedJulianDay returns the Modified Julian Day of the date, the day count from 1858-11-17.
+func DateToModifiedJulianDay(d Date) int64 {
+	return unixDays(d) + modifiedJulianDayUnixEpoch
+}
+
+// DateFromModifiedJulianDay returns the date of a Modified Julian Day.
+func DateFromModifiedJulianDay(mjd int64) (Date, error) {
+	days := mjd - modifiedJulianDayUnixEpoch
+	if days < minUnixDays || days > maxUnixDays {
+		return Date{}, fmt.Errorf("%w: modified julian day %d", ErrEpochRange, mjd)
+	}
+
+	return dateFromUnixDays(days), nil
+}
+
+// DateToRataDie returns the Rata Die of the date, where 0001-01-01 is day 1.
+func DateToRataDie(d Date) int64 {
+	return unixDays(d) + rataDieUnixEpoch
+}
+
+// DateFromRataDie returns the date of a Rata Die.
+func DateFromRataDie(rd int64) (Date, error) {
+	days := rd - rataDieUnixEpoch
+	if days < minUnixDays || days > maxUnixDays {
+		return Date{}, fmt.Errorf("%w: rata die %d", ErrEpochRange, rd)
+	}
+
+	return dateFromUnixDays(days), nil
+}
+
+// DateToDotNetTicks returns the .NET DateTime ticks, 100 nanosecond intervals since 0001-01-01, of midnight of the
+// date.
+func DateToDotNetTicks(d Date) int64 {
+	return (DateToRataDie(d) - 1) * ticksPerDay
+}
+
+// DateFromDotNetTicks returns the date of .NET DateTime ticks, ignoring the time of day.
+func DateFromDotNetTicks(ticks int64) (Date, error) {
+	onlyDate, _, err := DateTimeFromDotNetTicks(ticks)
+
+	return onlyDate, err
+}
+
+// DateTimeToDotNetTicks returns the .NET DateTime ticks of a date and a time of day in UTC.
+func DateTimeToDotNetTicks(d Date, t Time) int64 {
+	return DateToDotNetTicks(d) + nanosecondsOfDay(t)/nanosecondsPerTick
+}
+
+// DateTimeFromDotNetTicks splits .NET DateTime ticks into a date and a time of day in UTC. The DateTimeKind bits
+// of DateTime.ToBinary are not accepted, use the Ticks property.
+func DateTimeFromDotNetTicks(ticks int64) (Date, Time, error) {
+	if ticks < 0 || ticks/ticksPerDay > maxUnixDays+rataDieUnixEpoch-1 {
+		return Date{}, Time{}, fmt.Errorf("%w: %d ticks", ErrEpochRange, ticks)
+	}
+
+	onlyDate := dateFromUnixDays(ticks/ticksPerDay + 1 - rataDieUnixEpoch)
+	onlyTime := Time{time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(ticks % ticksPerDay * nanosecondsPerTick))}
+
+	return onlyDate, onlyTime, nil
+}
+
+// DateTimeToJulianDate returns the fractional Julian Date of a date and a time of day in UTC. Julian Dates start at
+// noon, so midnight of a date is its Julian Day Number minus 0.5.
+func DateTimeToJulianDate(d Date, t Time) float64 {
+	return float64(DateToJulianDay(d)) - julianDateNoon + float64(nanosecondsOfDay(t))/float64(secondsPerDay*time.Second)
+}
+
+// DateTimeFromJulianDate splits a fractional Julian Date into a date and a time of day in UTC. The time is rounded
+// to the millisecond, about the precision a float64 Julian Date holds for current dates.
+func DateTimeFromJulianDate(jd float64) (Date, Time, error) {
+	return fractionalDaysToDateTime(jd+julianDateNoon, julianDayUnixEpoch, "julian date")
+}
+
+// DateTimeToModifiedJulianDate returns the fractional Modified Julian Date of a date and a time of day in UTC.
+// Modified Julian Dates start at midnight.
+func DateTimeToModifiedJulianDate(d Date, t Time) float64 {
+	return float64(DateToModifiedJulianDay(d)) + float64(nanosecondsOfDay(t))/float64(secondsPerDay*time.Second)
+}
+
+// DateTimeFromModifiedJulianDate splits a fractional Modified Julian Date into a date and a time of day in UTC,
+// rounded to the millisecond.
+func DateTimeFromModifiedJulianDate(mjd float64) (Date, Time, error) {
+	return fractionalDaysToDateTime(mjd, modifiedJulianDayUnixEpoch, "modified julian date")
+}
+
+// fractionalDaysToDateTime splits a fractional day count, whose days start at midnight and whose day epochUnixDays
+// is 1970-01-01, into a date and a time of day rounded to the millisecond.
+func fractionalDaysToDateTime(days float64, epochUnixDays int64, name string) (Date, Time, error) {
+	if math.IsNaN(days) || days < float64(minUnixDays+epochUnixDays) || days >= float64(maxUnixDays+epochUnixDays+1) {
+		return Date{}, Time{}, fmt.Errorf("%w: %s %v", ErrEpochRange, name, days)
+	}
+
+	milliseconds := int64(math.Round(days * millisecondsPerDay))
+	whole, remainder := milliseconds/millisecondsPerDay, milliseconds%millisecondsPerDay
+
+	// Division rounds toward zero, so a negative count with a time part belongs to the day before.
+	if remainder < 0 {
+		whole--
+		remainder += millisecondsPerDay
+	}
+
+	unix := whole - epochUnixDays
+
+	if unix > maxUnixDays {
+		return Date{}, Time{}, fmt.Errorf("%w: %s %v", ErrEpochRange, name, days)
+	}
+
+	return dateFromUnixDays(unix), timeFromMilliseconds(remainder), nil
+}
+
+// unixDays returns the number of days from 1970-01-01 to the calendar date of d.
+func unixDays(d Date) int64 {
+	year, month, day := d.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay
+}
+
+// dateFromUnixDays returns the date the given number of days after 1970-01-01.
+func dateFromUnixDays(days int64) Date {
+	return Date{time.Unix(days*secondsPerDay, 0).UTC()}
+}
+
+// nanosecondsOfDay returns the nanoseconds since midnight UTC of t.
+func nanosecondsOfDay(t Time) int64 {
+	utc := t.UTC()
+
+	return int64(utc.Hour())*int64(time.Hour) + int64(utc.Minute())*int64(time.Minute) +
+		int64(utc.Second())*int64(time.Second) + int64(utc.Nanosecond())
+}
+
+// timeFromMilliseconds returns the time of day the given number of milliseconds after midnight UTC.
+func timeFromMilliseconds(milliseconds int64) Time {
+	return Time{time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(milliseconds) * time.Millisecond)}
+}
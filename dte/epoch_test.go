@@ -0,0 +1,266 @@
+package dte_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleDateToJulianDay() {
+	onlyDate, err := dte.NewDate("2000-01-01")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(dte.DateToJulianDay(onlyDate), dte.DateToModifiedJulianDay(onlyDate), dte.DateToRataDie(onlyDate))
+
+	// Output: 2451545 51544 730120
+}
+
+func ExampleDateTimeFromJulianDate() {
+	onlyDate, onlyTime, err := dte.DateTimeFromJulianDate(2451545.0)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(onlyDate, onlyTime)
+
+	// Output: 2000-01-01 12:00:00Z
+}
+
+func TestDateEpochs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputDate     string
+		wantUnix      int64
+		wantJulian    int64
+		wantModified  int64
+		wantRataDie   int64
+		wantDotNetDay int64
+	}{
+		{
+			name:          "first supported day",
+			inputDate:     "0001-01-01",
+			wantUnix:      -719162,
+			wantJulian:    1721426,
+			wantModified:  -678575,
+			wantRataDie:   1,
+			wantDotNetDay: 0,
+		},
+		{
+			name:          "modified julian epoch",
+			inputDate:     "1858-11-17",
+			wantUnix:      -40587,
+			wantJulian:    2400001,
+			wantModified:  0,
+			wantRataDie:   678576,
+			wantDotNetDay: 678575,
+		},
+		{
+			name:          "unix epoch",
+			inputDate:     "1970-01-01",
+			wantUnix:      0,
+			wantJulian:    2440588,
+			wantModified:  40587,
+			wantRataDie:   719163,
+			wantDotNetDay: 719162,
+		},
+		{
+			name:          "last supported day",
+			inputDate:     "9999-12-31",
+			wantUnix:      2932896,
+			wantJulian:    5373484,
+			wantModified:  2973483,
+			wantRataDie:   3652059,
+			wantDotNetDay: 3652058,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.inputDate)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			got := []int64{
+				dte.DateToUnixDays(onlyDate),
+				dte.DateToJulianDay(onlyDate),
+				dte.DateToModifiedJulianDay(onlyDate),
+				dte.DateToRataDie(onlyDate),
+				dte.DateToDotNetTicks(onlyDate),
+			}
+			want := []int64{tt.wantUnix, tt.wantJulian, tt.wantModified, tt.wantRataDie, tt.wantDotNetDay * 864_000_000_000}
+
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("DateTo*() = %v, want %v", got, want)
+			}
+
+			fromFuncs := []func(int64) (dte.Date, error){
+				dte.DateFromUnixDays,
+				dte.DateFromJulianDay,
+				dte.DateFromModifiedJulianDay,
+				dte.DateFromRataDie,
+				dte.DateFromDotNetTicks,
+			}
+
+			for i, from := range fromFuncs {
+				back, err := from(want[i])
+				if err != nil {
+					t.Fatalf("DateFrom*(%d) error = %v", want[i], err)
+				}
+
+				if back.String() != onlyDate.String() {
+					t.Errorf("DateFrom*(%d) = %v, want %v", want[i], back, onlyDate)
+				}
+			}
+		})
+	}
+}
+
+func TestDateEpochsRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		from  func(int64) (dte.Date, error)
+		input int64
+	}{
+		{name: "unix days before year 1", from: dte.DateFromUnixDays, input: -719163},
+		{name: "unix days after year 9999", from: dte.DateFromUnixDays, input: 2932897},
+		{name: "julian day before year 1", from: dte.DateFromJulianDay, input: 1721425},
+		{name: "julian day after year 9999", from: dte.DateFromJulianDay, input: 5373485},
+		{name: "modified julian day after year 9999", from: dte.DateFromModifiedJulianDay, input: 2973484},
+		{name: "rata die zero", from: dte.DateFromRataDie, input: 0},
+		{name: "negative ticks", from: dte.DateFromDotNetTicks, input: -1},
+		{name: "ticks after year 9999", from: dte.DateFromDotNetTicks, input: 3652059 * 864_000_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tt.from(tt.input)
+			if !errors.Is(err, dte.ErrEpochRange) {
+				t.Errorf("DateFrom*() error = %v, wantErr %v", err, dte.ErrEpochRange)
+			}
+		})
+	}
+}
+
+func TestDateTimeEpochs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		inputDate  string
+		inputTime  string
+		wantJulian float64
+		wantMJD    float64
+		wantTicks  int64
+	}{
+		{
+			name:       "j2000",
+			inputDate:  "2000-01-01",
+			inputTime:  "12:00:00Z",
+			wantJulian: 2451545.0,
+			wantMJD:    51544.5,
+			wantTicks:  630823248000000000,
+		},
+		{
+			name:       "midnight is half a julian day",
+			inputDate:  "1970-01-01",
+			inputTime:  "00:00:00Z",
+			wantJulian: 2440587.5,
+			wantMJD:    40587,
+			wantTicks:  621355968000000000,
+		},
+		{
+			name:       "offset is converted to UTC",
+			inputDate:  "1970-01-01",
+			inputTime:  "01:00:00+01:00",
+			wantJulian: 2440587.5,
+			wantMJD:    40587,
+			wantTicks:  621355968000000000,
+		},
+		{
+			name:       "sub second",
+			inputDate:  "2024-02-29",
+			inputTime:  "18:30:15.25Z",
+			wantJulian: 2460370.271009838,
+			wantMJD:    60369.771009838,
+			wantTicks:  638448282152500000,
+		},
+		{
+			name:       "before the modified julian day epoch",
+			inputDate:  "1858-11-16",
+			inputTime:  "18:00:00Z",
+			wantJulian: 2400000.25,
+			wantMJD:    -0.25,
+			wantTicks:  586288584000000000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.inputDate)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			onlyTime, err := dte.NewTime(tt.inputTime)
+			if err != nil {
+				t.Fatalf("NewTime() error = %v", err)
+			}
+
+			if got := dte.DateTimeToJulianDate(onlyDate, onlyTime); math.Abs(got-tt.wantJulian) > 1e-8 {
+				t.Errorf("DateTimeToJulianDate() = %v, want %v", got, tt.wantJulian)
+			}
+
+			if got := dte.DateTimeToModifiedJulianDate(onlyDate, onlyTime); math.Abs(got-tt.wantMJD) > 1e-8 {
+				t.Errorf("DateTimeToModifiedJulianDate() = %v, want %v", got, tt.wantMJD)
+			}
+
+			if got := dte.DateTimeToDotNetTicks(onlyDate, onlyTime); got != tt.wantTicks {
+				t.Errorf("DateTimeToDotNetTicks() = %v, want %v", got, tt.wantTicks)
+			}
+
+			checkDateTime := func(name string, gotDate dte.Date, gotTime dte.Time, err error) {
+				t.Helper()
+
+				if err != nil {
+					t.Fatalf("%s() error = %v", name, err)
+				}
+
+				if gotDate.String() != onlyDate.String() || !gotTime.Equal(onlyTime.Time) {
+					t.Errorf("%s() = %v %v, want %v %v", name, gotDate, gotTime, onlyDate, onlyTime)
+				}
+			}
+
+			gotDate, gotTime, err := dte.DateTimeFromJulianDate(tt.wantJulian)
+			checkDateTime("DateTimeFromJulianDate", gotDate, gotTime, err)
+
+			gotDate, gotTime, err = dte.DateTimeFromModifiedJulianDate(tt.wantMJD)
+			checkDateTime("DateTimeFromModifiedJulianDate", gotDate, gotTime, err)
+
+			gotDate, gotTime, err = dte.DateTimeFromDotNetTicks(tt.wantTicks)
+			checkDateTime("DateTimeFromDotNetTicks", gotDate, gotTime, err)
+		})
+	}
+
+	for _, jd := range []float64{math.NaN(), 1721424.4, 5373484.5} {
+		_, _, err := dte.DateTimeFromJulianDate(jd)
+		if !errors.Is(err, dte.ErrEpochRange) {
+			t.Errorf("DateTimeFromJulianDate(%v) error = %v, wantErr %v", jd, err, dte.ErrEpochRange)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	ErrExcelSerialRange = errors.New("excel serial is outside the range of the date system")
+	ErrExcelLeapBug     = errors.New("excel serial 60 is the fictitious 1900-02-29 of the 1900 date system")
+	ErrExcelDateSystem  = errors.New("unknown excel date system")
+)
+
+// ExcelDateSystem selects the epoch of Excel serial dates. Workbooks record which system they use, in XLSX files
+// the date1904 attribute of workbookPr.
+type ExcelDateSystem int
+
+const (
+	// ExcelDateSystem1900 is the default date system. Serial 1 is 1900-01-01 and serial 60 is the fictitious
+	// 1900-02-29 that Excel kept for Lotus 1-2-3 compatibility, so every serial from 61 on is one day after the real
+	// day count.
+	ExcelDateSystem1900 ExcelDateSystem = iota
+	// ExcelDateSystem1904 is the date system of older Excel for Mac workbooks. Serial 0 is 1904-01-01.
+	ExcelDateSystem1904
+)
+
+const (
+	excelLeapBugSerial = 60
+	excelMaxSerial1900 = 2958465 // 9999-12-31
+	excelMaxSerial1904 = 2957003 // 9999-12-31
+	// excel1900UnixDays is the number of days from 1899-12-30, serial 0 of the 1900 system when counting from
+	// 1900-03-01 backwards, to the Unix epoch.
+	excel1900UnixDays = 25569
+	// excel1904UnixDays is the number of days from 1904-01-01 to the Unix epoch.
+	excel1904UnixDays = 24107
+)
+
+// DateToExcel returns the Excel serial of a date in the given date system.
+func DateToExcel(d Date, system ExcelDateSystem) (int, error) {
+	days := unixDays(d)
+
+	switch system {
+	case ExcelDateSystem1900:
+		serial := days + excel1900UnixDays
+		if serial <= excelLeapBugSerial {
+			serial--
+		}
+
+		if serial < 1 || serial > excelMaxSerial1900 {
+			return 0, fmt.Errorf("%w: %s", ErrExcelSerialRange, d)
+		}
+
+		return int(serial), nil
+	case ExcelDateSystem1904:
+		serial := days + excel1904UnixDays
+		if serial < 0 || serial > excelMaxSerial1904 {
+			return 0, fmt.Errorf("%w: %s", ErrExcelSerialRange, d)
+		}
+
+		return int(serial), nil
+	default:
+		return 0, fmt.Errorf("%w: %d", ErrExcelDateSystem, system)
+	}
+}
+
+// DateFromExcel returns the date of an Excel serial in the given date system, ignoring any time of day fraction.
+// Serial 60 of the 1900 date system returns ErrExcelLeapBug since 1900-02-29 does not exist, callers decide whether
+// to read it as 1900-02-28 or 1900-03-01.
+func DateFromExcel(serial float64, system ExcelDateSystem) (Date, error) {
+	if math.IsNaN(serial) || serial < 0 || serial > excelMaxSerial1900+1 {
+		return Date{}, fmt.Errorf("%w: %v", ErrExcelSerialRange, serial)
+	}
+
+	return excelDayToDate(int64(math.Floor(serial)), system)
+}
+
+// TimeToExcel returns the time of day in UTC as the fraction of a day used by Excel.
+func TimeToExcel(t Time) float64 {
+	return float64(nanosecondsOfDay(t)) / float64(secondsPerDay*time.Second)
+}
+
+// TimeFromExcel returns the time of day of an Excel serial, so both a time only fraction such as 0.75 and a full
+// date time serial are accepted. The time is rounded to the millisecond, the precision Excel keeps, and a fraction
+// that rounds up to the next day becomes midnight.
+func TimeFromExcel(serial float64) (Time, error) {
+	if math.IsNaN(serial) || math.IsInf(serial, 0) || serial < 0 {
+		return Time{}, fmt.Errorf("%w: %v", ErrExcelSerialRange, serial)
+	}
+
+	milliseconds := int64(math.Round((serial - math.Floor(serial)) * millisecondsPerDay))
+
+	return timeFromMilliseconds(milliseconds % millisecondsPerDay), nil
+}
+
+// DateTimeToExcel returns the Excel serial of a date and a time of day in UTC in the given date system.
+func DateTimeToExcel(d Date, t Time, system ExcelDateSystem) (float64, error) {
+	serial, err := DateToExcel(d, system)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(serial) + TimeToExcel(t), nil
+}
+
+// DateTimeFromExcel splits an Excel serial into its date and time of day. The time is rounded to the millisecond and
+// a time that rounds up to midnight moves the date to the next day.
+func DateTimeFromExcel(serial float64, system ExcelDateSystem) (Date, Time, error) {
+	if math.IsNaN(serial) || serial < 0 || serial > excelMaxSerial1900+1 {
+		return Date{}, Time{}, fmt.Errorf("%w: %v", ErrExcelSerialRange, serial)
+	}
+
+	milliseconds := int64(math.Round(serial * millisecondsPerDay))
+
+	onlyDate, err := excelDayToDate(milliseconds/millisecondsPerDay, system)
+	if err != nil {
+		return Date{}, Time{}, err
+	}
+
+	return onlyDate, timeFromMilliseconds(milliseconds % millisecondsPerDay), nil
+}
+
+func excelDayToDate(serial int64, system ExcelDateSystem) (Date, error) {
+	switch system {
+	case ExcelDateSystem1900:
+		switch {
+		case serial < 1 || serial > excelMaxSerial1900:
+			return Date{}, fmt.Errorf("%w: %d", ErrExcelSerialRange, serial)
+		case serial == excelLeapBugSerial:
+			return Date{}, ErrExcelLeapBug
+		case serial < excelLeapBugSerial:
+			serial++
+		}
+
+		return dateFromUnixDays(serial - excel1900UnixDays), nil
+	case ExcelDateSystem1904:
+		if serial < 0 || serial > excelMaxSerial1904 {
+			return Date{}, fmt.Errorf("%w: %d", ErrExcelSerialRange, serial)
+		}
+
+		return dateFromUnixDays(serial - excel1904UnixDays), nil
+	default:
+		return Date{}, fmt.Errorf("%w: %d", ErrExcelDateSystem, system)
+	}
+}
@@ -0,0 +1,214 @@
+package dte_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleDateFromExcel() {
+	onlyDate, err := dte.DateFromExcel(45292, dte.ExcelDateSystem1900)
+	if err != nil {
+		return
+	}
+
+	serial, err := dte.DateToExcel(onlyDate, dte.ExcelDateSystem1904)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(onlyDate, serial)
+
+	// Output: 2024-01-01 43830
+}
+
+func ExampleDateTimeFromExcel() {
+	onlyDate, onlyTime, err := dte.DateTimeFromExcel(45292.604166666664, dte.ExcelDateSystem1900)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(onlyDate, onlyTime)
+
+	// Output: 2024-01-01 14:30:00Z
+}
+
+func TestDateExcel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputDate string
+		system    dte.ExcelDateSystem
+		want      int
+	}{
+		{name: "first day of 1900", inputDate: "1900-01-01", system: dte.ExcelDateSystem1900, want: 1},
+		{name: "before the fictitious leap day", inputDate: "1900-02-28", system: dte.ExcelDateSystem1900, want: 59},
+		{name: "after the fictitious leap day", inputDate: "1900-03-01", system: dte.ExcelDateSystem1900, want: 61},
+		{name: "unix epoch", inputDate: "1970-01-01", system: dte.ExcelDateSystem1900, want: 25569},
+		{name: "last supported day", inputDate: "9999-12-31", system: dte.ExcelDateSystem1900, want: 2958465},
+		{name: "first day of 1904", inputDate: "1904-01-01", system: dte.ExcelDateSystem1904, want: 0},
+		{name: "1904 system", inputDate: "2024-01-01", system: dte.ExcelDateSystem1904, want: 43830},
+		{name: "1904 last supported day", inputDate: "9999-12-31", system: dte.ExcelDateSystem1904, want: 2957003},
+		{
+			name:      "full timestamp keeps the date",
+			inputDate: "2024-01-01T23:00:00-05:00",
+			system:    dte.ExcelDateSystem1900,
+			want:      45292,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.inputDate)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			got, err := dte.DateToExcel(onlyDate, tt.system)
+			if err != nil {
+				t.Fatalf("DateToExcel() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("DateToExcel() = %v, want %v", got, tt.want)
+			}
+
+			back, err := dte.DateFromExcel(float64(got)+0.75, tt.system)
+			if err != nil {
+				t.Fatalf("DateFromExcel() error = %v", err)
+			}
+
+			if back.String() != onlyDate.String() {
+				t.Errorf("DateFromExcel() = %v, want %v", back, onlyDate)
+			}
+		})
+	}
+}
+
+func TestDateExcelErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		serial  float64
+		system  dte.ExcelDateSystem
+		wantErr error
+	}{
+		{name: "fictitious leap day", serial: 60, system: dte.ExcelDateSystem1900, wantErr: dte.ErrExcelLeapBug},
+		{name: "fictitious leap day with time", serial: 60.5, system: dte.ExcelDateSystem1900, wantErr: dte.ErrExcelLeapBug},
+		{name: "zero in 1900", serial: 0, system: dte.ExcelDateSystem1900, wantErr: dte.ErrExcelSerialRange},
+		{name: "negative", serial: -1, system: dte.ExcelDateSystem1904, wantErr: dte.ErrExcelSerialRange},
+		{name: "after 9999", serial: 2958466, system: dte.ExcelDateSystem1900, wantErr: dte.ErrExcelSerialRange},
+		{name: "1904 after 9999", serial: 2957004, system: dte.ExcelDateSystem1904, wantErr: dte.ErrExcelSerialRange},
+		{name: "not a number", serial: math.NaN(), system: dte.ExcelDateSystem1900, wantErr: dte.ErrExcelSerialRange},
+		{name: "infinity", serial: math.Inf(1), system: dte.ExcelDateSystem1900, wantErr: dte.ErrExcelSerialRange},
+		{name: "unknown system", serial: 1, system: dte.ExcelDateSystem(7), wantErr: dte.ErrExcelDateSystem},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dte.DateFromExcel(tt.serial, tt.system)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DateFromExcel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			_, _, err = dte.DateTimeFromExcel(tt.serial, tt.system)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DateTimeFromExcel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	before, err := dte.NewDate("1899-12-31")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	_, err = dte.DateToExcel(before, dte.ExcelDateSystem1900)
+	if !errors.Is(err, dte.ErrExcelSerialRange) {
+		t.Errorf("DateToExcel() error = %v, wantErr %v", err, dte.ErrExcelSerialRange)
+	}
+}
+
+func TestTimeExcel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		serial    float64
+		inputTime string
+	}{
+		{name: "midnight", serial: 0, inputTime: "00:00:00Z"},
+		{name: "noon", serial: 0.5, inputTime: "12:00:00Z"},
+		{name: "inexact fraction", serial: 0.6041666666666666, inputTime: "14:30:00Z"},
+		{name: "offset is converted to UTC", serial: 0.75, inputTime: "13:00:00-05:00"},
+		{name: "date part is ignored", serial: 45292.25, inputTime: "06:00:00Z"},
+		{name: "rounds up to midnight", serial: 0.9999999999, inputTime: "00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			want, err := dte.NewTime(tt.inputTime)
+			if err != nil {
+				t.Fatalf("NewTime() error = %v", err)
+			}
+
+			got, err := dte.TimeFromExcel(tt.serial)
+			if err != nil {
+				t.Fatalf("TimeFromExcel() error = %v", err)
+			}
+
+			if !got.Equal(want.Time) {
+				t.Errorf("TimeFromExcel() = %v, want %v", got, want)
+			}
+
+			if fraction := dte.TimeToExcel(want); math.Abs(fraction-(tt.serial-math.Floor(tt.serial))) > 1e-6 &&
+				tt.name != "rounds up to midnight" {
+				t.Errorf("TimeToExcel() = %v, want %v", fraction, tt.serial)
+			}
+		})
+	}
+
+	_, err := dte.TimeFromExcel(-0.5)
+	if !errors.Is(err, dte.ErrExcelSerialRange) {
+		t.Errorf("TimeFromExcel() error = %v, wantErr %v", err, dte.ErrExcelSerialRange)
+	}
+}
+
+func TestDateTimeExcel(t *testing.T) {
+	t.Parallel()
+
+	onlyDate, err := dte.NewDate("2024-12-31")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	onlyTime, err := dte.NewTime("23:59:59.9999Z")
+	if err != nil {
+		t.Fatalf("NewTime() error = %v", err)
+	}
+
+	serial, err := dte.DateTimeToExcel(onlyDate, onlyTime, dte.ExcelDateSystem1904)
+	if err != nil {
+		t.Fatalf("DateTimeToExcel() error = %v", err)
+	}
+
+	gotDate, gotTime, err := dte.DateTimeFromExcel(serial, dte.ExcelDateSystem1904)
+	if err != nil {
+		t.Fatalf("DateTimeFromExcel() error = %v", err)
+	}
+
+	if got := fmt.Sprint(gotDate, " ", gotTime); got != "2025-01-01 00:00:00Z" {
+		t.Errorf("DateTimeFromExcel() = %v, want rounding to the next day", got)
+	}
+}
@@ -0,0 +1,216 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrFiscalCalendar    = errors.New("fiscal year must start on the first of a month from January to December")
+	ErrFiscalPeriodRange = errors.New("fiscal period is not in the fiscal year")
+)
+
+// FiscalYearNaming is the calendar year a fiscal year that does not start in January is named after.
+type FiscalYearNaming int
+
+const (
+	// FiscalYearNamedByEnd names a fiscal year after the calendar year it ends in, so with an October start FY2024
+	// runs from 2023-10-01 to 2024-09-30.
+	FiscalYearNamedByEnd FiscalYearNaming = iota
+	// FiscalYearNamedByStart names a fiscal year after the calendar year it starts in, so with an April start FY2024
+	// runs from 2024-04-01 to 2025-03-31.
+	FiscalYearNamedByStart
+)
+
+const (
+	quartersPerYear = 4
+	halvesPerYear   = 2
+)
+
+// FiscalCalendar is a year of twelve months starting on the first of a month, which Quarter and Half divide into
+// periods. Its zero value is the calendar year starting in January.
+type FiscalCalendar struct {
+	start  time.Month
+	naming FiscalYearNaming
+}
+
+// NewFiscalCalendar returns the fiscal calendar whose years start on the first of the start month.
+func NewFiscalCalendar(start time.Month, naming FiscalYearNaming) (FiscalCalendar, error) {
+	if start < time.January || start > time.December {
+		return FiscalCalendar{}, fmt.Errorf("%w: month %d", ErrFiscalCalendar, start)
+	}
+
+	if naming != FiscalYearNamedByEnd && naming != FiscalYearNamedByStart {
+		return FiscalCalendar{}, fmt.Errorf("%w: unknown naming %d", ErrFiscalCalendar, naming)
+	}
+
+	return FiscalCalendar{start: start, naming: naming}, nil
+}
+
+// StartMonth returns the month the fiscal years start in.
+func (c FiscalCalendar) StartMonth() time.Month {
+	if c.start == 0 {
+		return time.January
+	}
+
+	return c.start
+}
+
+// Naming returns the calendar year the fiscal years are named after.
+func (c FiscalCalendar) Naming() FiscalYearNaming {
+	return c.naming
+}
+
+// YearStart returns the first day of the fiscal year.
+func (c FiscalCalendar) YearStart(year int) Date {
+	if c.naming == FiscalYearNamedByEnd && c.StartMonth() != time.January {
+		year--
+	}
+
+	return Date{time.Date(year, c.StartMonth(), 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// YearEnd returns the last day of the fiscal year.
+func (c FiscalCalendar) YearEnd(year int) Date {
+	return Date{c.YearStart(year).AddDate(1, 0, -1)}
+}
+
+// YearOf returns the fiscal year the date is in.
+func (c FiscalCalendar) YearOf(d Date) int {
+	year := d.Year()
+	if d.Month() < c.StartMonth() {
+		year--
+	}
+
+	if c.naming == FiscalYearNamedByEnd && c.StartMonth() != time.January {
+		year++
+	}
+
+	return year
+}
+
+// Quarter returns the quarter, 1 to 4, of the fiscal year.
+func (c FiscalCalendar) Quarter(year int, quarter int) (Quarter, error) {
+	period, err := c.period(year, quarter, quartersPerYear)
+	if err != nil {
+		return Quarter{}, err
+	}
+
+	return Quarter{period}, nil
+}
+
+// QuarterOf returns the fiscal quarter the date is in.
+func (c FiscalCalendar) QuarterOf(d Date) Quarter {
+	return Quarter{c.periodOf(d, quartersPerYear)}
+}
+
+// Half returns the half, 1 or 2, of the fiscal year.
+func (c FiscalCalendar) Half(year int, half int) (Half, error) {
+	period, err := c.period(year, half, halvesPerYear)
+	if err != nil {
+		return Half{}, err
+	}
+
+	return Half{period}, nil
+}
+
+// HalfOf returns the fiscal half year the date is in.
+func (c FiscalCalendar) HalfOf(d Date) Half {
+	return Half{c.periodOf(d, halvesPerYear)}
+}
+
+// fiscalPeriod is the shared implementation of Quarter and Half, period number of perYear equal periods of the
+// fiscal year.
+type fiscalPeriod struct {
+	calendar FiscalCalendar
+	year     int
+	number   int
+}
+
+func (c FiscalCalendar) period(year int, number int, perYear int) (fiscalPeriod, error) {
+	if year < minYear || year > maxYear || number < 1 || number > perYear {
+		return fiscalPeriod{}, fmt.Errorf("%w: period %d of %d in %04d", ErrFiscalPeriodRange, number, perYear, year)
+	}
+
+	return fiscalPeriod{calendar: c, year: year, number: number}, nil
+}
+
+func (c FiscalCalendar) periodOf(d Date, perYear int) fiscalPeriod {
+	year := c.YearOf(d)
+	months := (int(d.Month()) - int(c.StartMonth()) + monthsPerYear) % monthsPerYear
+
+	return fiscalPeriod{calendar: c, year: year, number: months/(monthsPerYear/perYear) + 1}
+}
+
+func (p fiscalPeriod) start(perYear int) Date {
+	return Date{p.calendar.YearStart(p.year).AddDate(0, (p.number-1)*(monthsPerYear/perYear), 0)}
+}
+
+func (p fiscalPeriod) end(perYear int) Date {
+	return Date{p.start(perYear).AddDate(0, monthsPerYear/perYear, -1)}
+}
+
+func (p fiscalPeriod) contains(d Date, perYear int) bool {
+	return p.number != 0 && p.calendar.periodOf(d, perYear) == p
+}
+
+func (p fiscalPeriod) add(n int, perYear int) fiscalPeriod {
+	index := p.year*perYear + p.number - 1 + n
+	year := floorDiv(index, perYear)
+
+	return fiscalPeriod{calendar: p.calendar, year: year, number: index - year*perYear + 1}
+}
+
+func (p fiscalPeriod) diff(other fiscalPeriod, perYear int) int {
+	return (p.year-other.year)*perYear + p.number - other.number
+}
+
+func (p fiscalPeriod) compare(other fiscalPeriod, perYear int) int {
+	return p.start(perYear).Compare(other.start(perYear).Time)
+}
+
+// string returns the period in the yyyy-Xn format, where X is the letter of the period.
+func (p fiscalPeriod) string(letter string) string {
+	if p.number == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%04d-%s%d", p.year, letter, p.number)
+}
+
+// parsePeriod parses a period in the yyyy-Xn, yyyyXn or "Xn yyyy" format, where X is the letter of the period, not
+// case sensitive.
+func (c FiscalCalendar) parsePeriod(s string, letter string, perYear int, errParse error) (fiscalPeriod, error) {
+	text := strings.ToUpper(strings.TrimSpace(s))
+
+	var yearText, numberText string
+
+	if rest, ok := strings.CutPrefix(text, letter); ok {
+		numberText, yearText, _ = strings.Cut(rest, " ")
+	} else if i := strings.LastIndex(text, letter); i >= 0 {
+		yearText, numberText = strings.TrimSuffix(text[:i], "-"), text[i+len(letter):]
+	}
+
+	year, yearOK := parseDigits(yearText, len(yearOnly))
+	number, numberOK := parseDigits(numberText, 1)
+
+	if !yearOK || !numberOK {
+		return fiscalPeriod{}, fmt.Errorf("%w: %q", errParse, s)
+	}
+
+	return c.period(year, number, perYear)
+}
+
+// parseDigits returns the number of a string of exactly width digits.
+func parseDigits(s string, width int) (int, bool) {
+	if len(s) != width || strings.Trim(s, "0123456789") != "" {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(s)
+
+	return number, err == nil
+}
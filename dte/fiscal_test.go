@@ -0,0 +1,140 @@
+package dte_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleFiscalCalendar() {
+	federal, err := dte.NewFiscalCalendar(time.October, dte.FiscalYearNamedByEnd)
+	if err != nil {
+		return
+	}
+
+	onlyDate, err := dte.NewDate("2023-11-15")
+	if err != nil {
+		return
+	}
+
+	quarter := federal.QuarterOf(onlyDate)
+
+	fmt.Println(federal.YearOf(onlyDate), quarter, quarter.Start(), quarter.End(), federal.YearEnd(2024))
+
+	// Output: 2024 2024-Q1 2023-10-01 2023-12-31 2024-09-30
+}
+
+func TestFiscalCalendar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		start       time.Month
+		naming      dte.FiscalYearNaming
+		date        string
+		wantYear    int
+		wantQuarter string
+		wantHalf    string
+		wantStart   string
+	}{
+		{
+			name:        "calendar",
+			start:       time.January,
+			naming:      dte.FiscalYearNamedByEnd,
+			date:        "2024-08-15",
+			wantYear:    2024,
+			wantQuarter: "2024-Q3",
+			wantHalf:    "2024-H2",
+			wantStart:   "2024-01-01",
+		},
+		{
+			name:        "named by end",
+			start:       time.October,
+			naming:      dte.FiscalYearNamedByEnd,
+			date:        "2024-09-30",
+			wantYear:    2024,
+			wantQuarter: "2024-Q4",
+			wantHalf:    "2024-H2",
+			wantStart:   "2023-10-01",
+		},
+		{
+			name:        "named by start",
+			start:       time.April,
+			naming:      dte.FiscalYearNamedByStart,
+			date:        "2025-01-10",
+			wantYear:    2024,
+			wantQuarter: "2024-Q4",
+			wantHalf:    "2024-H2",
+			wantStart:   "2024-04-01",
+		},
+		{
+			name:        "first month",
+			start:       time.July,
+			naming:      dte.FiscalYearNamedByEnd,
+			date:        "2024-07-01",
+			wantYear:    2025,
+			wantQuarter: "2025-Q1",
+			wantHalf:    "2025-H1",
+			wantStart:   "2024-07-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar, err := dte.NewFiscalCalendar(tt.start, tt.naming)
+			if err != nil {
+				t.Fatalf("NewFiscalCalendar() error = %v", err)
+			}
+
+			onlyDate, err := dte.NewDate(tt.date)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			if got := calendar.YearOf(onlyDate); got != tt.wantYear {
+				t.Errorf("YearOf() = %v, want %v", got, tt.wantYear)
+			}
+
+			quarter, half := calendar.QuarterOf(onlyDate), calendar.HalfOf(onlyDate)
+			if quarter.String() != tt.wantQuarter || half.String() != tt.wantHalf {
+				t.Errorf("QuarterOf(), HalfOf() = %v, %v, want %v, %v", quarter, half, tt.wantQuarter, tt.wantHalf)
+			}
+
+			if !quarter.Contains(onlyDate) || !half.Contains(onlyDate) || quarter.Half() != half {
+				t.Errorf("Contains() is not correct for %v and %v", quarter, half)
+			}
+
+			yearStart := calendar.YearStart(tt.wantYear)
+			if yearStart.String() != tt.wantStart || calendar.YearOf(yearStart) != tt.wantYear {
+				t.Errorf("YearStart() = %v, want %v", yearStart, tt.wantStart)
+			}
+
+			if calendar.YearOf(dte.Date{Time: yearStart.AddDate(0, 0, -1)}) != tt.wantYear-1 {
+				t.Errorf("YearOf() of the day before YearStart() is not the previous year")
+			}
+		})
+	}
+}
+
+func TestNewFiscalCalendarErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := dte.NewFiscalCalendar(13, dte.FiscalYearNamedByEnd)
+	if !errors.Is(err, dte.ErrFiscalCalendar) {
+		t.Errorf("NewFiscalCalendar() error = %v, wantErr %v", err, dte.ErrFiscalCalendar)
+	}
+
+	_, err = dte.NewFiscalCalendar(time.April, 2)
+	if !errors.Is(err, dte.ErrFiscalCalendar) {
+		t.Errorf("NewFiscalCalendar() error = %v, wantErr %v", err, dte.ErrFiscalCalendar)
+	}
+
+	if month := (dte.FiscalCalendar{}).StartMonth(); month != time.January {
+		t.Errorf("StartMonth() = %v, want %v", month, time.January)
+	}
+}
@@ -0,0 +1,155 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrHalfParse = errors.New("half year does not follow the yyyy-Hn, yyyyHn or Hn yyyy format")
+
+// Half is a half of a fiscal year, such as 2024-H2. Halves of the zero FiscalCalendar are calendar halves, 2024-H2
+// running from 2024-07-01 to 2024-12-31. Its zero value is no half year at all.
+type Half struct { //nolint:recvcheck
+	period fiscalPeriod
+}
+
+// NewHalf returns the half, 1 or 2, of the calendar year.
+func NewHalf(year int, half int) (Half, error) {
+	return FiscalCalendar{}.Half(year, half)
+}
+
+// HalfOf returns the calendar half year the date is in.
+func HalfOf(d Date) Half {
+	return FiscalCalendar{}.HalfOf(d)
+}
+
+// ParseHalf parses a calendar half year in the yyyy-Hn, yyyyHn or "Hn yyyy" format.
+func ParseHalf(s string) (Half, error) {
+	return FiscalCalendar{}.ParseHalf(s)
+}
+
+// ParseHalf parses a half of the fiscal calendar in the yyyy-Hn, yyyyHn or "Hn yyyy" format, where yyyy is the fiscal
+// year.
+func (c FiscalCalendar) ParseHalf(s string) (Half, error) {
+	period, err := c.parsePeriod(s, "H", halvesPerYear, ErrHalfParse)
+	if err != nil {
+		return Half{}, err
+	}
+
+	return Half{period}, nil
+}
+
+// IsZero reports whether h is the zero Half.
+func (h Half) IsZero() bool {
+	return h.period.number == 0
+}
+
+// Calendar returns the fiscal calendar of the half year.
+func (h Half) Calendar() FiscalCalendar {
+	return h.period.calendar
+}
+
+// Year returns the fiscal year of the half year.
+func (h Half) Year() int {
+	return h.period.year
+}
+
+// Number returns the number of the half in its fiscal year, 1 or 2.
+func (h Half) Number() int {
+	return h.period.number
+}
+
+// Start returns the first day of the half year.
+func (h Half) Start() Date {
+	return h.period.start(halvesPerYear)
+}
+
+// End returns the last day of the half year.
+func (h Half) End() Date {
+	return h.period.end(halvesPerYear)
+}
+
+// Contains reports whether the date is in the half year.
+func (h Half) Contains(d Date) bool {
+	return h.period.contains(d, halvesPerYear)
+}
+
+// Quarters returns the two quarters of the half year.
+func (h Half) Quarters() (Quarter, Quarter) {
+	first := h.period.calendar.QuarterOf(h.Start())
+
+	return first, first.AddQuarters(1)
+}
+
+// AddHalves returns the half year n halves after h, or before it for a negative n.
+func (h Half) AddHalves(n int) Half {
+	return Half{h.period.add(n, halvesPerYear)}
+}
+
+// Diff returns the number of half years from other to h, which must be of the same fiscal calendar.
+func (h Half) Diff(other Half) int {
+	return h.period.diff(other.period, halvesPerYear)
+}
+
+// Compare returns -1 when h starts before other, 1 when it starts after and 0 when both start on the same day.
+func (h Half) Compare(other Half) int {
+	return h.period.compare(other.period, halvesPerYear)
+}
+
+// Before reports whether h starts before other.
+func (h Half) Before(other Half) bool {
+	return h.Compare(other) < 0
+}
+
+// After reports whether h starts after other.
+func (h Half) After(other Half) bool {
+	return h.Compare(other) > 0
+}
+
+// String returns the half year in the yyyy-Hn format, or an empty string for the zero Half.
+func (h Half) String() string {
+	return h.period.string("H")
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The half year is a quoted string in the yyyy-Hn format, or null for the zero Half.
+func (h Half) MarshalJSON() ([]byte, error) {
+	if h.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + h.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The half year must be a quoted string in any format ParseHalf accepts, null leaves it unchanged. The half year is
+// of the fiscal calendar h already has, so a zero Half is a calendar half year.
+func (h *Half) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("Half.UnmarshalJSON: %w: input is not a JSON string", ErrHalfParse)
+	}
+
+	return h.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (h Half) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. The half year is of the fiscal calendar h
+// already has.
+func (h *Half) UnmarshalText(data []byte) error {
+	parsed, err := h.period.calendar.ParseHalf(string(data))
+	if err != nil {
+		return err
+	}
+
+	*h = parsed
+
+	return nil
+}
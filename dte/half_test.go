@@ -0,0 +1,97 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseHalf() {
+	half, err := dte.ParseHalf("H2 2024")
+	if err != nil {
+		return
+	}
+
+	first, second := half.Quarters()
+
+	fmt.Println(half, half.Start(), half.End(), half.AddHalves(1), first, second)
+
+	// Output: 2024-H2 2024-07-01 2024-12-31 2025-H1 2024-Q3 2024-Q4
+}
+
+func TestParseHalf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input     string
+		want      string
+		wantStart string
+		wantEnd   string
+		wantErr   error
+	}{
+		{input: "2024-H1", want: "2024-H1", wantStart: "2024-01-01", wantEnd: "2024-06-30"},
+		{input: "2024H2", want: "2024-H2", wantStart: "2024-07-01", wantEnd: "2024-12-31"},
+		{input: "h1 2025", want: "2025-H1", wantStart: "2025-01-01", wantEnd: "2025-06-30"},
+		{input: "2024-H3", wantErr: dte.ErrFiscalPeriodRange},
+		{input: "2024-Q1", wantErr: dte.ErrHalfParse},
+		{input: "2024-H", wantErr: dte.ErrHalfParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseHalf(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseHalf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.String() != tt.want || got.Start().String() != tt.wantStart || got.End().String() != tt.wantEnd {
+				t.Errorf("ParseHalf() = %v from %v to %v, want %v", got, got.Start(), got.End(), tt.want)
+			}
+
+			if !got.Contains(got.End()) || got.Contains(got.AddHalves(1).Start()) {
+				t.Errorf("Contains() is not correct for %v", got)
+			}
+		})
+	}
+}
+
+func TestHalfNavigation(t *testing.T) {
+	t.Parallel()
+
+	onlyDate, err := dte.NewDate("2024-03-31")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	half := dte.HalfOf(onlyDate)
+	previous := half.AddHalves(-3)
+
+	if half.String() != "2024-H1" || previous.String() != "2022-H2" || half.Diff(previous) != 3 {
+		t.Errorf("AddHalves() = %v, %v with Diff() %v", half, previous, half.Diff(previous))
+	}
+
+	if !previous.Before(half) || half.Compare(half) != 0 || half.Year() != 2024 || half.Number() != 1 {
+		t.Errorf("Compare() = %v", previous.Compare(half))
+	}
+
+	var zero dte.Half
+
+	err = json.Unmarshal([]byte(`"2024-H2"`), &zero)
+	if err != nil || zero.String() != "2024-H2" {
+		t.Errorf("Unmarshal() = %v, %v, want 2024-H2", zero, err)
+	}
+
+	err = json.Unmarshal([]byte(`2024`), &zero)
+	if !errors.Is(err, dte.ErrHalfParse) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, dte.ErrHalfParse)
+	}
+}
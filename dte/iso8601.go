@@ -0,0 +1,412 @@
+package dte
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrISO8601Parse = errors.New("value does not follow an ISO 8601 format")
+
+// ISODateRepresentation selects how FormatISODate writes a date.
+type ISODateRepresentation int
+
+const (
+	// ISODateCalendar is the year, month and day such as 2024-01-05.
+	ISODateCalendar ISODateRepresentation = iota
+	// ISODateOrdinal is the year and day of the year such as 2024-005.
+	ISODateOrdinal
+	// ISODateWeek is the ISO week numbering year, week and weekday such as 2024-W01-5.
+	ISODateWeek
+)
+
+// ISOFormat selects between the extended format with separators and the basic format without them.
+type ISOFormat int
+
+const (
+	// ISOExtended writes separators, 2024-01-05 and 10:15:00Z.
+	ISOExtended ISOFormat = iota
+	// ISOBasic leaves separators out, 20240105 and 101500Z.
+	ISOBasic
+)
+
+// ISOTimePrecision selects the lowest order component FormatISOTime writes.
+type ISOTimePrecision int
+
+const (
+	// ISOTimeHour writes the hour only, 10Z.
+	ISOTimeHour ISOTimePrecision = iota
+	// ISOTimeMinute writes hours and minutes, 10:15Z.
+	ISOTimeMinute
+	// ISOTimeSecond writes hours, minutes and seconds, 10:15:00Z.
+	ISOTimeSecond
+	// ISOTimeFraction writes hours, minutes and seconds with as many fraction digits as needed, 10:15:00.25Z.
+	ISOTimeFraction
+)
+
+const (
+	isoMaxHour          = 23
+	isoMaxMinute        = 59
+	isoMaxSecond        = 59
+	isoMaxFractionWidth = 9
+	isoDigitBase        = 10
+	daysPerWeek         = 7
+)
+
+// isoDatePatterns are the complete ISO 8601-1 date representations. Y is a year digit, M a month digit, D a day of
+// the month digit, O a day of the year digit, w a week digit and e the weekday digit; other characters are literal.
+var isoDatePatterns = []string{ //nolint:gochecknoglobals
+	"YYYY-MM-DD",
+	"YYYYMMDD",
+	"YYYY-OOO",
+	"YYYYOOO",
+	"YYYY-Www-e",
+	"YYYYWwwe",
+}
+
+// isoClockPatterns are the ISO 8601-1 local time representations, including reduced precision, before any decimal
+// fraction. h is an hour digit, m a minute digit and s a second digit.
+var isoClockPatterns = []string{ //nolint:gochecknoglobals
+	"hh:mm:ss",
+	"hhmmss",
+	"hh:mm",
+	"hhmm",
+	"hh",
+}
+
+// isoZonePatterns are the ISO 8601-1 UTC offset representations after the sign. h is an hour digit and m a minute
+// digit.
+var isoZonePatterns = []string{ //nolint:gochecknoglobals
+	"hh:mm",
+	"hhmm",
+	"hh",
+}
+
+// ISODate is a Date that accepts every ISO 8601-1 date representation, not only yyyy-mm-dd and RFC 3339, when
+// decoding JSON. It still encodes as yyyy-mm-dd.
+type ISODate struct { //nolint:recvcheck
+	Date
+}
+
+// ISOTime is a Time that accepts every ISO 8601-1 time representation, including the basic format and reduced
+// precision, when decoding JSON. It still encodes as hh:mm:ss with the time zone.
+type ISOTime struct { //nolint:recvcheck
+	Time
+}
+
+// ParseISODate parses a date in any ISO 8601-1 calendar, ordinal or week date representation, in the extended or the
+// basic format. Like NewDate it also accepts a date and time joined by T, keeping the time and its offset.
+func ParseISODate(s string) (Date, error) {
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+
+	parsed, err := parseISODate(datePart)
+	if err != nil {
+		return Date{}, err
+	}
+
+	if !hasTime {
+		return Date{parsed}, nil
+	}
+
+	clock, err := parseISOTime(timePart)
+	if err != nil {
+		return Date{}, err
+	}
+
+	year, month, day := parsed.Date()
+
+	return Date{time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(),
+		clock.Location())}, nil
+}
+
+// ParseISOTime parses a time of day in any ISO 8601-1 representation: the extended or basic format, an optional
+// leading T, reduced precision such as 10:15 or 10, and a decimal fraction of the lowest order component such as
+// 10:15:30.5 or 10.25. A time without a UTC offset is read as UTC. 24:00 and leap seconds are rejected since Time can
+// not hold them.
+func ParseISOTime(s string) (Time, error) {
+	parsed, err := parseISOTime(strings.TrimPrefix(s, "T"))
+	if err != nil {
+		return Time{}, err
+	}
+
+	return Time{parsed.UTC()}, nil
+}
+
+// FormatISODate formats the date in the given ISO 8601-1 representation and format.
+func FormatISODate(d Date, representation ISODateRepresentation, format ISOFormat) string {
+	separator := "-"
+	if format == ISOBasic {
+		separator = ""
+	}
+
+	switch representation {
+	case ISODateOrdinal:
+		return fmt.Sprintf("%04d%s%03d", d.Year(), separator, d.YearDay())
+	case ISODateWeek:
+		year, week := d.ISOWeek()
+
+		return fmt.Sprintf("%04d%sW%02d%s%d", year, separator, week, separator, isoWeekday(d.Weekday()))
+	default:
+		return fmt.Sprintf("%04d%s%02d%s%02d", d.Year(), separator, d.Month(), separator, d.Day())
+	}
+}
+
+// FormatISOTime formats the time of day to the given precision in the ISO 8601-1 format, with Z for UTC and the
+// offset otherwise.
+func FormatISOTime(t Time, precision ISOTimePrecision, format ISOFormat) string {
+	var layout string
+
+	switch precision {
+	case ISOTimeHour:
+		layout = "15"
+	case ISOTimeMinute:
+		layout = "15:04"
+	case ISOTimeSecond:
+		layout = "15:04:05"
+	default:
+		layout = "15:04:05.999999999"
+	}
+
+	if format == ISOBasic {
+		return t.Format(strings.ReplaceAll(layout, ":", "") + "Z0700")
+	}
+
+	return t.Format(layout + "Z07:00")
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The date must be a quoted string in any representation accepted by ParseISODate.
+func (d *ISODate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == "\"null\"" {
+		return nil
+	}
+
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return fmt.Errorf("ISODate.UnmarshalJSON: input is not a JSON string: %w", err)
+	}
+
+	parsed, err := ParseISODate(s)
+	if err != nil {
+		return err
+	}
+
+	d.Date = parsed
+
+	return nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The time must be a quoted string in any representation accepted by ParseISOTime.
+func (t *ISOTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == "\"null\"" {
+		return nil
+	}
+
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return fmt.Errorf("ISOTime.UnmarshalJSON: input is not a JSON string: %w", err)
+	}
+
+	parsed, err := ParseISOTime(s)
+	if err != nil {
+		return err
+	}
+
+	t.Time = parsed
+
+	return nil
+}
+
+// parseISODate returns midnight UTC of a date in one of the isoDatePatterns.
+func parseISODate(s string) (time.Time, error) {
+	for _, pattern := range isoDatePatterns {
+		fields, ok := matchISOPattern(s, pattern)
+		if !ok {
+			continue
+		}
+
+		year := fields['Y']
+
+		switch {
+		case strings.ContainsRune(pattern, 'O'):
+			parsed := time.Date(year, time.January, fields['O'], 0, 0, 0, 0, time.UTC)
+			if fields['O'] < 1 || parsed.Year() != year {
+				return time.Time{}, fmt.Errorf("%w: year %04d has no day %03d", ErrISO8601Parse, year, fields['O'])
+			}
+
+			return parsed, nil
+		case strings.ContainsRune(pattern, 'w'):
+			return isoWeekDate(year, fields['w'], fields['e'])
+		default:
+			parsed := time.Date(year, time.Month(fields['M']), fields['D'], 0, 0, 0, 0, time.UTC)
+			if parsed.Month() != time.Month(fields['M']) || parsed.Day() != fields['D'] {
+				return time.Time{}, fmt.Errorf("%w: %q is not a valid date", ErrISO8601Parse, s)
+			}
+
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q is not a complete calendar, ordinal or week date", ErrISO8601Parse, s)
+}
+
+// isoWeekDate returns midnight UTC of the weekday, 1 for Monday, of the week of the ISO week numbering year.
+func isoWeekDate(year int, week int, weekday int) (time.Time, error) {
+	if weekday < 1 || weekday > daysPerWeek {
+		return time.Time{}, fmt.Errorf("%w: weekday %d is not between 1 and 7", ErrISO8601Parse, weekday)
+	}
+
+	parsed := ISOWeek{year: year, week: week}.Day(time.Weekday(weekday % daysPerWeek)).Time
+
+	if parsedYear, parsedWeek := parsed.ISOWeek(); week < 1 || parsedYear != year || parsedWeek != week {
+		return time.Time{}, fmt.Errorf("%w: year %04d has no week %02d", ErrISO8601Parse, year, week)
+	}
+
+	return parsed, nil
+}
+
+// isoWeekday returns the ISO 8601 weekday number, 1 for Monday to 7 for Sunday.
+func isoWeekday(weekday time.Weekday) int {
+	if weekday == time.Sunday {
+		return daysPerWeek
+	}
+
+	return int(weekday)
+}
+
+// parseISOTime returns the time of day on 0000-01-01 in its own offset, or UTC when it has none.
+func parseISOTime(s string) (time.Time, error) {
+	clock, zone := s, ""
+	if i := strings.IndexAny(s, "Z+-"); i >= 0 {
+		clock, zone = s[:i], s[i:]
+	}
+
+	location, err := parseISOZone(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	clock, fraction, hasFraction := strings.Cut(strings.ReplaceAll(clock, ",", "."), ".")
+
+	for _, pattern := range isoClockPatterns {
+		fields, ok := matchISOPattern(clock, pattern)
+		if !ok {
+			continue
+		}
+
+		if fields['h'] > isoMaxHour || fields['m'] > isoMaxMinute || fields['s'] > isoMaxSecond {
+			return time.Time{}, fmt.Errorf("%w: %q is not a valid time of day", ErrISO8601Parse, clock)
+		}
+
+		parsed := time.Date(0, time.January, 1, fields['h'], fields['m'], fields['s'], 0, location)
+
+		if !hasFraction {
+			return parsed, nil
+		}
+
+		unit := time.Second
+
+		switch pattern {
+		case "hh:mm", "hhmm":
+			unit = time.Minute
+		case "hh":
+			unit = time.Hour
+		}
+
+		extra, err := isoFraction(fraction, unit)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return parsed.Add(extra), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q is not an hh:mm:ss, hh:mm or hh time", ErrISO8601Parse, clock)
+}
+
+// parseISOZone returns the location of a Z or ±hh[:mm] UTC offset, UTC when there is none.
+func parseISOZone(zone string) (*time.Location, error) {
+	if zone == "" || zone == "Z" {
+		return time.UTC, nil
+	}
+
+	for _, pattern := range isoZonePatterns {
+		fields, ok := matchISOPattern(zone[1:], pattern)
+		if !ok {
+			continue
+		}
+
+		if fields['h'] > isoMaxHour || fields['m'] > isoMaxMinute {
+			return nil, fmt.Errorf("%w: %q is not a valid UTC offset", ErrISO8601Parse, zone)
+		}
+
+		offset := int((time.Duration(fields['h'])*time.Hour + time.Duration(fields['m'])*time.Minute).Seconds())
+		if zone[0] == '-' {
+			offset = -offset
+		}
+
+		return time.FixedZone("", offset), nil
+	}
+
+	return nil, fmt.Errorf("%w: %q is not a Z, ±hh:mm, ±hhmm or ±hh UTC offset", ErrISO8601Parse, zone)
+}
+
+// isoFraction returns the duration of a decimal fraction of the unit, rounded to the nanosecond.
+func isoFraction(fraction string, unit time.Duration) (time.Duration, error) {
+	if fraction == "" || strings.Trim(fraction, "0123456789") != "" {
+		return 0, fmt.Errorf("%w: %q is not a decimal fraction", ErrISO8601Parse, fraction)
+	}
+
+	if len(fraction) > isoMaxFractionWidth {
+		fraction = fraction[:isoMaxFractionWidth]
+	}
+
+	numerator, err := strconv.Atoi(fraction)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a decimal fraction: %w", ErrISO8601Parse, fraction, err)
+	}
+
+	scale := math.Pow(isoDigitBase, float64(len(fraction)))
+
+	return time.Duration(math.Round(float64(numerator) / scale * float64(unit))), nil
+}
+
+// matchISOPattern matches s against a pattern whose letters stand for digits and whose other characters are literal,
+// returning the number formed by the digits of every letter.
+func matchISOPattern(s string, pattern string) (map[byte]int, bool) {
+	if len(s) != len(pattern) {
+		return nil, false
+	}
+
+	fields := map[byte]int{}
+
+	for i := range len(pattern) {
+		letter := pattern[i]
+
+		isDigitPlaceholder := letter >= 'a' && letter <= 'z' || letter >= 'A' && letter <= 'Z' && letter != 'W'
+		if !isDigitPlaceholder {
+			if s[i] != letter {
+				return nil, false
+			}
+
+			continue
+		}
+
+		if s[i] < '0' || s[i] > '9' {
+			return nil, false
+		}
+
+		fields[letter] = fields[letter]*isoDigitBase + int(s[i]-'0')
+	}
+
+	return fields, true
+}
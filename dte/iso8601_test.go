@@ -0,0 +1,229 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseISODate() {
+	for _, input := range []string{"20240105", "2024-W01-5", "2024-005", "2024W015"} {
+		onlyDate, err := dte.ParseISODate(input)
+		if err != nil {
+			return
+		}
+
+		fmt.Println(onlyDate)
+	}
+
+	// Output:
+	// 2024-01-05
+	// 2024-01-05
+	// 2024-01-05
+	// 2024-01-05
+}
+
+func ExampleFormatISODate() {
+	onlyDate, err := dte.NewDate("2024-12-30")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(dte.FormatISODate(onlyDate, dte.ISODateWeek, dte.ISOExtended))
+	fmt.Println(dte.FormatISODate(onlyDate, dte.ISODateOrdinal, dte.ISOBasic))
+
+	// Output:
+	// 2025-W01-1
+	// 2024365
+}
+
+func ExampleISOTime() {
+	type Opening struct {
+		Opens  dte.ISOTime `json:"opens"`
+		Closes dte.ISOTime `json:"closes"`
+	}
+
+	var opening Opening
+
+	err := json.Unmarshal([]byte(`{"opens":"0830","closes":"17:30-05:00"}`), &opening)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(opening)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled))
+
+	// Output: {"opens":"08:30:00Z","closes":"22:30:00Z"}
+}
+
+func TestParseISODate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "calendar extended", input: "2024-02-29", want: "2024-02-29", wantErr: nil},
+		{name: "calendar basic", input: "20240229", want: "2024-02-29", wantErr: nil},
+		{name: "ordinal extended", input: "2024-366", want: "2024-12-31", wantErr: nil},
+		{name: "ordinal basic", input: "2023001", want: "2023-01-01", wantErr: nil},
+		{name: "week in previous year", input: "2020-W01-1", want: "2019-12-30", wantErr: nil},
+		{name: "week 53", input: "2020-W53-7", want: "2021-01-03", wantErr: nil},
+		{name: "week basic", input: "2009W537", want: "2010-01-03", wantErr: nil},
+		{name: "date time keeps its zone", input: "20240105T2300-05:00", want: "2024-01-05", wantErr: nil},
+		{name: "invalid day", input: "2023-02-29", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "ordinal day 366 of a common year", input: "2023-366", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "week 53 of a 52 week year", input: "2021-W53-1", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "week 0", input: "2021-W00-1", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "weekday 8", input: "2021-W10-8", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "reduced precision month", input: "2024-01", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "mixed separators", input: "2024-0105", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "bad time", input: "2024-01-05T25:00", want: "", wantErr: dte.ErrISO8601Parse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseISODate(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseISODate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseISODate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseISOTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "extended", input: "10:15:30Z", want: "10:15:30Z", wantErr: nil},
+		{name: "basic", input: "101500Z", want: "10:15:00Z", wantErr: nil},
+		{name: "leading T", input: "T10:15", want: "10:15:00Z", wantErr: nil},
+		{name: "minutes without zone", input: "10:15", want: "10:15:00Z", wantErr: nil},
+		{name: "hour only", input: "10Z", want: "10:00:00Z", wantErr: nil},
+		{name: "basic minutes with basic offset", input: "1015+0130", want: "08:45:00Z", wantErr: nil},
+		{name: "hour offset", input: "10:15:00-03", want: "13:15:00Z", wantErr: nil},
+		{name: "fraction of a second", input: "10:15:30.25Z", want: "10:15:30.25Z", wantErr: nil},
+		{name: "comma fraction", input: "10:15:30,5", want: "10:15:30.5Z", wantErr: nil},
+		{name: "fraction of a minute", input: "10:15.5", want: "10:15:30Z", wantErr: nil},
+		{name: "fraction of an hour", input: "10,25Z", want: "10:15:00Z", wantErr: nil},
+		{name: "end of day", input: "24:00:00", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "leap second", input: "23:59:60Z", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "bad offset", input: "10:15+1", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "empty fraction", input: "10:15:30.Z", want: "", wantErr: dte.ErrISO8601Parse},
+		{name: "three digit hour", input: "101Z", want: "", wantErr: dte.ErrISO8601Parse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseISOTime(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseISOTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && dte.FormatISOTime(got, dte.ISOTimeFraction, dte.ISOExtended) != tt.want {
+				t.Errorf("ParseISOTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatISO(t *testing.T) {
+	t.Parallel()
+
+	onlyDate, err := dte.NewDate("2024-01-05")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	onlyTime, err := dte.NewTime("10:15:30.5Z")
+	if err != nil {
+		t.Fatalf("NewTime() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "calendar", got: dte.FormatISODate(onlyDate, dte.ISODateCalendar, dte.ISOExtended), want: "2024-01-05"},
+		{name: "calendar basic", got: dte.FormatISODate(onlyDate, dte.ISODateCalendar, dte.ISOBasic), want: "20240105"},
+		{name: "ordinal", got: dte.FormatISODate(onlyDate, dte.ISODateOrdinal, dte.ISOExtended), want: "2024-005"},
+		{name: "week", got: dte.FormatISODate(onlyDate, dte.ISODateWeek, dte.ISOExtended), want: "2024-W01-5"},
+		{name: "week basic", got: dte.FormatISODate(onlyDate, dte.ISODateWeek, dte.ISOBasic), want: "2024W015"},
+		{name: "hour", got: dte.FormatISOTime(onlyTime, dte.ISOTimeHour, dte.ISOExtended), want: "10Z"},
+		{name: "minute basic", got: dte.FormatISOTime(onlyTime, dte.ISOTimeMinute, dte.ISOBasic), want: "1015Z"},
+		{name: "second", got: dte.FormatISOTime(onlyTime, dte.ISOTimeSecond, dte.ISOExtended), want: "10:15:30Z"},
+		{name: "fraction basic", got: dte.FormatISOTime(onlyTime, dte.ISOTimeFraction, dte.ISOBasic), want: "101530.5Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.got != tt.want {
+				t.Errorf("Format = %v, want %v", tt.got, tt.want)
+			}
+
+			if parsed, err := dte.ParseISODate(tt.got); err == nil && parsed.String() != onlyDate.String() {
+				t.Errorf("ParseISODate(%v) = %v, want %v", tt.got, parsed, onlyDate)
+			}
+		})
+	}
+}
+
+func TestISODateJSON(t *testing.T) {
+	t.Parallel()
+
+	type TestStruct struct {
+		Date  dte.ISODate  `json:"date"`
+		Empty *dte.ISODate `json:"empty"`
+	}
+
+	var testStruct TestStruct
+
+	err := json.Unmarshal([]byte(`{"date":"2024-W01-5","empty":null}`), &testStruct)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	marshaled, err := json.Marshal(testStruct)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if want := `{"date":"2024-01-05","empty":null}`; string(marshaled) != want {
+		t.Errorf("Marshal() = %s, want %s", marshaled, want)
+	}
+
+	err = json.Unmarshal([]byte(`{"date":20240105}`), &testStruct)
+	if err == nil {
+		t.Errorf("Unmarshal() error = nil, want an error for a JSON number")
+	}
+
+	err = json.Unmarshal([]byte(`{"date":"2024-W54-1"}`), &testStruct)
+	if !errors.Is(err, dte.ErrISO8601Parse) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, dte.ErrISO8601Parse)
+	}
+}
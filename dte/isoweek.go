@@ -0,0 +1,185 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrISOWeekParse = errors.New("iso week does not follow the yyyy-Www or yyyyWww format")
+	ErrISOWeekRange = errors.New("iso week is not a week of the iso week numbering year")
+)
+
+// ISOWeek is a week of an ISO 8601 week numbering year, such as 2024-W01. Weeks run from Monday to Sunday and week 1
+// is the week with the first Thursday of the year, so the week numbering year of the days around New Year may
+// differ from their calendar year. Its zero value is no week at all.
+type ISOWeek struct { //nolint:recvcheck
+	year int
+	week int
+}
+
+// NewISOWeek returns the week of the ISO week numbering year, which must be between 1 and ISOWeeksInYear.
+func NewISOWeek(year int, week int) (ISOWeek, error) {
+	if year < minYear || year > maxYear || week < 1 || week > ISOWeeksInYear(year) {
+		return ISOWeek{}, fmt.Errorf("%w: %04d-W%02d", ErrISOWeekRange, year, week)
+	}
+
+	return ISOWeek{year: year, week: week}, nil
+}
+
+// ISOWeekOf returns the ISO week of the date, so 2024-12-30 is in 2025-W01.
+func ISOWeekOf(d Date) ISOWeek {
+	year, week := d.ISOWeek()
+
+	return ISOWeek{year: year, week: week}
+}
+
+// ISOWeeksInYear returns the number of weeks, 52 or 53, of the ISO week numbering year. December 28th is always in
+// the last week.
+func ISOWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+
+	return week
+}
+
+// ParseISOWeek parses an ISO week in the extended yyyy-Www or the basic yyyyWww format.
+func ParseISOWeek(s string) (ISOWeek, error) {
+	for _, pattern := range []string{"YYYY-Www", "YYYYWww"} {
+		fields, ok := matchISOPattern(s, pattern)
+		if !ok {
+			continue
+		}
+
+		return NewISOWeek(fields['Y'], fields['w'])
+	}
+
+	return ISOWeek{}, fmt.Errorf("%w: %q", ErrISOWeekParse, s)
+}
+
+// IsZero reports whether w is the zero ISOWeek.
+func (w ISOWeek) IsZero() bool {
+	return w.week == 0
+}
+
+// Year returns the ISO week numbering year, which may differ from the calendar year of some of its days.
+func (w ISOWeek) Year() int {
+	return w.year
+}
+
+// Week returns the week number, 1 to 53.
+func (w ISOWeek) Week() int {
+	return w.week
+}
+
+// WeeksInYear returns the number of weeks of the ISO week numbering year of w.
+func (w ISOWeek) WeeksInYear() int {
+	return ISOWeeksInYear(w.year)
+}
+
+// Day returns the date of the weekday in the week.
+func (w ISOWeek) Day(weekday time.Weekday) Date {
+	// Week 1 is the week with the year's first Thursday, so it always holds January 4th.
+	january4 := time.Date(w.year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	week1Monday := january4.AddDate(0, 0, 1-isoWeekday(january4.Weekday()))
+
+	return Date{week1Monday.AddDate(0, 0, (w.week-1)*daysPerWeek+isoWeekday(weekday)-1)}
+}
+
+// Monday returns the first day of the week.
+func (w ISOWeek) Monday() Date {
+	return w.Day(time.Monday)
+}
+
+// Sunday returns the last day of the week.
+func (w ISOWeek) Sunday() Date {
+	return w.Day(time.Sunday)
+}
+
+// Contains reports whether the date is in the week.
+func (w ISOWeek) Contains(d Date) bool {
+	return !w.IsZero() && ISOWeekOf(d) == w
+}
+
+// AddWeeks returns the week n weeks after w, or before it for a negative n, crossing into the next or previous week
+// numbering year after its week 52 or 53.
+func (w ISOWeek) AddWeeks(n int) ISOWeek {
+	return ISOWeekOf(Date{w.Monday().AddDate(0, 0, n*daysPerWeek)})
+}
+
+// Diff returns the number of weeks from other to w, so 2025-W01 minus 2024-W52 is 1.
+func (w ISOWeek) Diff(other ISOWeek) int {
+	return int(unixDays(w.Monday())-unixDays(other.Monday())) / daysPerWeek
+}
+
+// Compare returns -1 when w is before other, 1 when it is after and 0 when both are the same week.
+func (w ISOWeek) Compare(other ISOWeek) int {
+	switch {
+	case w.year < other.year || w.year == other.year && w.week < other.week:
+		return -1
+	case w == other:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Before reports whether w is before other.
+func (w ISOWeek) Before(other ISOWeek) bool {
+	return w.Compare(other) < 0
+}
+
+// After reports whether w is after other.
+func (w ISOWeek) After(other ISOWeek) bool {
+	return w.Compare(other) > 0
+}
+
+// String returns the week in the yyyy-Www format, or an empty string for the zero ISOWeek.
+func (w ISOWeek) String() string {
+	if w.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%04d-W%02d", w.year, w.week)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The week is a quoted string in the yyyy-Www format, or null for the zero ISOWeek.
+func (w ISOWeek) MarshalJSON() ([]byte, error) {
+	if w.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + w.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The week must be a quoted string in the yyyy-Www or yyyyWww format, null leaves it unchanged.
+func (w *ISOWeek) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("ISOWeek.UnmarshalJSON: %w: input is not a JSON string", ErrISOWeekParse)
+	}
+
+	return w.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (w ISOWeek) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (w *ISOWeek) UnmarshalText(data []byte) error {
+	parsed, err := ParseISOWeek(string(data))
+	if err != nil {
+		return err
+	}
+
+	*w = parsed
+
+	return nil
+}
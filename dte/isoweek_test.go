@@ -0,0 +1,192 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleISOWeekOf() {
+	newYearsEve, err := dte.NewDate("2024-12-30")
+	if err != nil {
+		return
+	}
+
+	week := dte.ISOWeekOf(newYearsEve)
+
+	fmt.Println(week, week.Monday(), week.Sunday(), week.AddWeeks(-1), week.AddWeeks(-1).WeeksInYear())
+
+	// Output: 2025-W01 2024-12-30 2025-01-05 2024-W52 52
+}
+
+func ExampleISOWeek_json() {
+	type Sprint struct {
+		Week dte.ISOWeek `json:"week"`
+	}
+
+	var sprint Sprint
+
+	err := json.Unmarshal([]byte(`{"week":"2020W53"}`), &sprint)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(sprint)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled), sprint.Week.Monday())
+
+	// Output: {"week":"2020-W53"} 2020-12-28
+}
+
+func TestParseISOWeek(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input      string
+		want       string
+		wantMonday string
+		wantErr    error
+	}{
+		{input: "2024-W01", want: "2024-W01", wantMonday: "2024-01-01"},
+		{input: "2026W01", want: "2026-W01", wantMonday: "2025-12-29"},
+		{input: "2020-W53", want: "2020-W53", wantMonday: "2020-12-28"},
+		{input: "2015-W53", want: "2015-W53", wantMonday: "2015-12-28"},
+		{input: "2021-W53", wantErr: dte.ErrISOWeekRange},
+		{input: "2024-W00", wantErr: dte.ErrISOWeekRange},
+		{input: "2024-W1", wantErr: dte.ErrISOWeekParse},
+		{input: "2024-01", wantErr: dte.ErrISOWeekParse},
+		{input: "2024-W01-1", wantErr: dte.ErrISOWeekParse},
+		{input: "", wantErr: dte.ErrISOWeekParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseISOWeek(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseISOWeek() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.String() != tt.want || got.Monday().String() != tt.wantMonday {
+				t.Errorf("ParseISOWeek() = %v starting %v, want %v starting %v", got, got.Monday(), tt.want, tt.wantMonday)
+			}
+
+			if got.Sunday().Sub(got.Monday().Time) != 6*24*time.Hour || !got.Contains(got.Sunday()) {
+				t.Errorf("Sunday() = %v", got.Sunday())
+			}
+		})
+	}
+}
+
+func TestISOWeekOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date string
+		want string
+	}{
+		{date: "2021-01-03", want: "2020-W53"},
+		{date: "2021-01-04", want: "2021-W01"},
+		{date: "2024-12-29", want: "2024-W52"},
+		{date: "2024-12-30", want: "2025-W01"},
+		{date: "2027-01-01", want: "2026-W53"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.date)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			got := dte.ISOWeekOf(onlyDate)
+			if got.String() != tt.want || !got.Contains(onlyDate) {
+				t.Errorf("ISOWeekOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestISOWeekArithmetic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		start string
+		weeks int
+		want  string
+	}{
+		{start: "2020-W52", weeks: 1, want: "2020-W53"},
+		{start: "2020-W53", weeks: 1, want: "2021-W01"},
+		{start: "2021-W01", weeks: -1, want: "2020-W53"},
+		{start: "2024-W52", weeks: 1, want: "2025-W01"},
+		{start: "2024-W10", weeks: 104, want: "2026-W10"},
+		{start: "2024-W10", weeks: 0, want: "2024-W10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.start, tt.weeks), func(t *testing.T) {
+			t.Parallel()
+
+			start, err := dte.ParseISOWeek(tt.start)
+			if err != nil {
+				t.Fatalf("ParseISOWeek() error = %v", err)
+			}
+
+			got := start.AddWeeks(tt.weeks)
+			if got.String() != tt.want {
+				t.Errorf("AddWeeks() = %v, want %v", got, tt.want)
+			}
+
+			if got.Diff(start) != tt.weeks || start.Diff(got) != -tt.weeks {
+				t.Errorf("Diff() = %v, want %v", got.Diff(start), tt.weeks)
+			}
+
+			if tt.weeks > 0 && (!got.After(start) || !start.Before(got)) || tt.weeks == 0 && got.Compare(start) != 0 {
+				t.Errorf("Compare() = %v", got.Compare(start))
+			}
+		})
+	}
+}
+
+func TestISOWeeksInYear(t *testing.T) {
+	t.Parallel()
+
+	weeks53 := map[int]bool{2004: true, 2009: true, 2015: true, 2020: true, 2026: true, 2032: true}
+
+	for year := 2000; year <= 2035; year++ {
+		want := 52
+		if weeks53[year] {
+			want = 53
+		}
+
+		if got := dte.ISOWeeksInYear(year); got != want {
+			t.Errorf("ISOWeeksInYear(%d) = %v, want %v", year, got, want)
+		}
+	}
+
+	var zero dte.ISOWeek
+
+	marshaled, err := json.Marshal(zero)
+	if err != nil || string(marshaled) != "null" || !zero.IsZero() {
+		t.Errorf("Marshal() = %s, %v, want null", marshaled, err)
+	}
+
+	_, err = dte.NewISOWeek(0, 1)
+	if !errors.Is(err, dte.ErrISOWeekRange) {
+		t.Errorf("NewISOWeek() error = %v, wantErr %v", err, dte.ErrISOWeekRange)
+	}
+}
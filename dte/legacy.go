@@ -0,0 +1,216 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrLegacyDateParse   = errors.New("date does not follow the legacy date layout")
+	ErrLegacyDateRange   = errors.New("date year can not be written in the legacy date layout")
+	ErrLegacyLayout      = errors.New("unknown legacy date layout")
+	ErrYearWindow        = errors.New("year window must span between 1 and 100 years")
+	ErrYearOutsideWindow = errors.New("two digit year is outside the allowed year window")
+)
+
+// LegacyLayout is a fixed width, digits only date layout used by EDI and mainframe feeds.
+type LegacyLayout int
+
+const (
+	// LegacyYYMMDD is a two digit year, month and day such as 240229. The century comes from a YearWindow.
+	LegacyYYMMDD LegacyLayout = iota
+	// LegacyYYDDD is a two digit year and the day of the year such as 24060, often called a Julian date on
+	// mainframes. The century comes from a YearWindow.
+	LegacyYYDDD
+	// LegacyCYYMMDD is a century digit, two digit year, month and day such as 1240229, where century digit 0 is
+	// 19xx, 1 is 20xx and so on up to 9 for 28xx.
+	LegacyCYYMMDD
+	// LegacyCCYYMMDD is a four digit year, month and day such as 20240229.
+	LegacyCCYYMMDD
+)
+
+const (
+	yearsPerCentury = 100
+	// legacyCenturyBase is the year of century digit 0 in LegacyCYYMMDD.
+	legacyCenturyBase = 1900
+	legacyMaxCentury  = 9
+)
+
+// YearWindow is the range of four digit years that two digit years map to. A window spanning 100 years maps every
+// two digit year, a shorter window rejects the two digit years it does not cover with ErrYearOutsideWindow.
+type YearWindow struct {
+	first int
+	last  int
+}
+
+// NewYearWindow returns the window from the first to the last year, both inclusive.
+func NewYearWindow(first int, last int) (YearWindow, error) {
+	if last < first || last-first >= yearsPerCentury {
+		return YearWindow{}, fmt.Errorf("%w: %d to %d", ErrYearWindow, first, last)
+	}
+
+	return YearWindow{first: first, last: last}, nil
+}
+
+// FixedYearWindow returns the 100 year window starting at the pivot year, so a pivot of 1950 maps 50 to 1950 and
+// 49 to 2049.
+func FixedYearWindow(pivot int) YearWindow {
+	return YearWindow{first: pivot, last: pivot + yearsPerCentury - 1}
+}
+
+// SlidingYearWindow returns the window from yearsBack years before to yearsForward years after the year of now, so
+// it moves forward as time passes. yearsBack plus yearsForward must be below 100.
+func SlidingYearWindow(now time.Time, yearsBack int, yearsForward int) (YearWindow, error) {
+	if yearsBack < 0 || yearsForward < 0 {
+		return YearWindow{}, fmt.Errorf("%w: %d years back and %d years forward", ErrYearWindow, yearsBack, yearsForward)
+	}
+
+	return NewYearWindow(now.Year()-yearsBack, now.Year()+yearsForward)
+}
+
+// First returns the first year of the window.
+func (w YearWindow) First() int {
+	return w.first
+}
+
+// Last returns the last year of the window.
+func (w YearWindow) Last() int {
+	return w.last
+}
+
+// Year returns the four digit year of a two digit year within the window.
+func (w YearWindow) Year(twoDigitYear int) (int, error) {
+	if twoDigitYear < 0 || twoDigitYear >= yearsPerCentury {
+		return 0, fmt.Errorf("%w: %d is not a two digit year", ErrYearOutsideWindow, twoDigitYear)
+	}
+
+	year := w.first - w.first%yearsPerCentury + twoDigitYear
+	if year < w.first {
+		year += yearsPerCentury
+	}
+
+	if year > w.last {
+		return 0, fmt.Errorf("%w: %02d in %d to %d", ErrYearOutsideWindow, twoDigitYear, w.first, w.last)
+	}
+
+	return year, nil
+}
+
+// Contains reports whether the four digit year is within the window.
+func (w YearWindow) Contains(year int) bool {
+	return year >= w.first && year <= w.last
+}
+
+// ParseLegacyDate parses a date in a legacy layout. The window maps the two digit years of LegacyYYMMDD and
+// LegacyYYDDD and is ignored by the other layouts.
+func ParseLegacyDate(s string, layout LegacyLayout, window YearWindow) (Date, error) {
+	width, err := layout.width()
+	if err != nil {
+		return Date{}, err
+	}
+
+	if len(s) != width {
+		return Date{}, fmt.Errorf("%w: %q is not %d digits", ErrLegacyDateParse, s, width)
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return Date{}, fmt.Errorf("%w: %q is not %d digits", ErrLegacyDateParse, s, width)
+		}
+	}
+
+	digits := func(from int, to int) int {
+		value, _ := strconv.Atoi(s[from:to])
+
+		return value
+	}
+
+	var year int
+
+	switch layout {
+	case LegacyYYMMDD, LegacyYYDDD:
+		year, err = window.Year(digits(0, 2))
+		if err != nil {
+			return Date{}, err
+		}
+	case LegacyCYYMMDD:
+		year = legacyCenturyBase + digits(0, 1)*yearsPerCentury + digits(1, 3)
+	case LegacyCCYYMMDD:
+		year = digits(0, 4)
+	}
+
+	if layout == LegacyYYDDD {
+		dayOfYear := digits(2, 5)
+
+		parsed := time.Date(year, time.January, dayOfYear, 0, 0, 0, 0, time.UTC)
+		if dayOfYear < 1 || parsed.Year() != year {
+			return Date{}, fmt.Errorf("%w: %q has no day %d", ErrLegacyDateParse, s, dayOfYear)
+		}
+
+		return Date{parsed}, nil
+	}
+
+	month, day := digits(width-4, width-2), digits(width-2, width)
+
+	parsed := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if parsed.Month() != time.Month(month) || parsed.Day() != day {
+		return Date{}, fmt.Errorf("%w: %q is not a valid date", ErrLegacyDateParse, s)
+	}
+
+	return Date{parsed}, nil
+}
+
+// FormatLegacyDate formats a date in a legacy layout. The window is only used by LegacyYYMMDD and LegacyYYDDD,
+// where a year outside it returns ErrYearOutsideWindow since it would not parse back to the same date.
+func FormatLegacyDate(d Date, layout LegacyLayout, window YearWindow) (string, error) {
+	_, err := layout.width()
+	if err != nil {
+		return "", err
+	}
+
+	year := d.Year()
+
+	switch layout {
+	case LegacyYYMMDD, LegacyYYDDD:
+		if !window.Contains(year) {
+			return "", fmt.Errorf("%w: %d in %d to %d", ErrYearOutsideWindow, year, window.first, window.last)
+		}
+
+		if layout == LegacyYYDDD {
+			return fmt.Sprintf("%02d%03d", year%yearsPerCentury, d.YearDay()), nil
+		}
+
+		return fmt.Sprintf("%02d%02d%02d", year%yearsPerCentury, d.Month(), d.Day()), nil
+	case LegacyCYYMMDD:
+		century := (year - legacyCenturyBase) / yearsPerCentury
+		if year < legacyCenturyBase || century > legacyMaxCentury {
+			return "", fmt.Errorf("%w: %d", ErrLegacyDateRange, year)
+		}
+
+		return fmt.Sprintf("%d%02d%02d%02d", century, year%yearsPerCentury, d.Month(), d.Day()), nil
+	default:
+		if year < 0 || year > maxYear {
+			return "", fmt.Errorf("%w: %d", ErrLegacyDateRange, year)
+		}
+
+		return d.Format("20060102"), nil
+	}
+}
+
+// width returns the number of digits of the layout.
+func (l LegacyLayout) width() (int, error) {
+	switch l {
+	case LegacyYYMMDD:
+		return len("YYMMDD"), nil
+	case LegacyYYDDD:
+		return len("YYDDD"), nil
+	case LegacyCYYMMDD:
+		return len("CYYMMDD"), nil
+	case LegacyCCYYMMDD:
+		return len("CCYYMMDD"), nil
+	default:
+		return 0, fmt.Errorf("%w: %d", ErrLegacyLayout, l)
+	}
+}
@@ -0,0 +1,207 @@
+package dte_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseLegacyDate() {
+	onlyDate, err := dte.ParseLegacyDate("490229", dte.LegacyYYMMDD, dte.FixedYearWindow(1950))
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	onlyDate, err = dte.ParseLegacyDate("1240229", dte.LegacyCYYMMDD, dte.YearWindow{})
+	if err != nil {
+		return
+	}
+
+	formatted, err := dte.FormatLegacyDate(onlyDate, dte.LegacyYYDDD, dte.FixedYearWindow(1950))
+	if err != nil {
+		return
+	}
+
+	fmt.Println(onlyDate, formatted)
+
+	// Output:
+	// date does not follow the legacy date layout: "490229" is not a valid date
+	// 2024-02-29 24060
+}
+
+func ExampleSlidingYearWindow() {
+	window, err := dte.SlidingYearWindow(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), 80, 19)
+	if err != nil {
+		return
+	}
+
+	past, err := window.Year(44)
+	if err != nil {
+		return
+	}
+
+	future, err := window.Year(43)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(window.First(), window.Last(), past, future)
+
+	// Output: 1944 2043 1944 2043
+}
+
+func TestParseLegacyDate(t *testing.T) {
+	t.Parallel()
+
+	window1950 := dte.FixedYearWindow(1950)
+	shipping := func() dte.YearWindow { w, _ := dte.NewYearWindow(2000, 2049); return w }() //nolint:nlreturn
+
+	tests := []struct {
+		name    string
+		input   string
+		layout  dte.LegacyLayout
+		window  dte.YearWindow
+		want    string
+		wantErr error
+	}{
+		{name: "yymmdd after pivot", input: "500101", layout: dte.LegacyYYMMDD, window: window1950, want: "1950-01-01"},
+		{name: "yymmdd before pivot", input: "491231", layout: dte.LegacyYYMMDD, window: window1950, want: "2049-12-31"},
+		{name: "yyddd leap day", input: "24060", layout: dte.LegacyYYDDD, window: window1950, want: "2024-02-29"},
+		{name: "yyddd last day", input: "23365", layout: dte.LegacyYYDDD, window: window1950, want: "2023-12-31"},
+		{name: "cyymmdd 19xx", input: "0991231", layout: dte.LegacyCYYMMDD, window: dte.YearWindow{}, want: "1999-12-31"},
+		{name: "cyymmdd 20xx", input: "1000101", layout: dte.LegacyCYYMMDD, window: dte.YearWindow{}, want: "2000-01-01"},
+		{name: "ccyymmdd", input: "18991231", layout: dte.LegacyCCYYMMDD, window: dte.YearWindow{}, want: "1899-12-31"},
+		{name: "narrow window", input: "250704", layout: dte.LegacyYYMMDD, window: shipping, want: "2025-07-04"},
+		{
+			name:    "outside narrow window",
+			input:   "750704",
+			layout:  dte.LegacyYYMMDD,
+			window:  shipping,
+			wantErr: dte.ErrYearOutsideWindow,
+		},
+		{name: "yyddd day 366", input: "23366", layout: dte.LegacyYYDDD, window: window1950, wantErr: dte.ErrLegacyDateParse},
+		{name: "yyddd day 0", input: "23000", layout: dte.LegacyYYDDD, window: window1950, wantErr: dte.ErrLegacyDateParse},
+		{name: "month 13", input: "241301", layout: dte.LegacyYYMMDD, window: window1950, wantErr: dte.ErrLegacyDateParse},
+		{name: "short", input: "24011", layout: dte.LegacyYYMMDD, window: window1950, wantErr: dte.ErrLegacyDateParse},
+		{name: "not digits", input: "24-1-1", layout: dte.LegacyYYMMDD, window: window1950, wantErr: dte.ErrLegacyDateParse},
+		{
+			name:    "unknown layout",
+			input:   "240101",
+			layout:  dte.LegacyLayout(9),
+			window:  window1950,
+			wantErr: dte.ErrLegacyLayout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseLegacyDate(tt.input, tt.layout, tt.window)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseLegacyDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("ParseLegacyDate() = %v, want %v", got, tt.want)
+			}
+
+			formatted, err := dte.FormatLegacyDate(got, tt.layout, tt.window)
+			if err != nil {
+				t.Fatalf("FormatLegacyDate() error = %v", err)
+			}
+
+			if formatted != tt.input {
+				t.Errorf("FormatLegacyDate() = %v, want %v", formatted, tt.input)
+			}
+		})
+	}
+}
+
+func TestFormatLegacyDateErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		layout  dte.LegacyLayout
+		wantErr error
+	}{
+		{name: "outside window", input: "1949-12-31", layout: dte.LegacyYYMMDD, wantErr: dte.ErrYearOutsideWindow},
+		{name: "before century digit 0", input: "1899-12-31", layout: dte.LegacyCYYMMDD, wantErr: dte.ErrLegacyDateRange},
+		{name: "after century digit 9", input: "2900-01-01", layout: dte.LegacyCYYMMDD, wantErr: dte.ErrLegacyDateRange},
+		{name: "unknown layout", input: "2000-01-01", layout: dte.LegacyLayout(-1), wantErr: dte.ErrLegacyLayout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.input)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			_, err = dte.FormatLegacyDate(onlyDate, tt.layout, dte.FixedYearWindow(1950))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("FormatLegacyDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// LegacyCCYYMMDD writes four digit years only, outside of which NewDate can not make a date.
+	for _, year := range []int{-1, 10000} {
+		onlyDate := dte.Date{Time: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)}
+
+		_, err := dte.FormatLegacyDate(onlyDate, dte.LegacyCCYYMMDD, dte.FixedYearWindow(1950))
+		if !errors.Is(err, dte.ErrLegacyDateRange) {
+			t.Errorf("FormatLegacyDate(%d) error = %v, wantErr %v", year, err, dte.ErrLegacyDateRange)
+		}
+	}
+}
+
+func TestYearWindow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		first   int
+		last    int
+		wantErr error
+	}{
+		{name: "single year", first: 2024, last: 2024, wantErr: nil},
+		{name: "full century", first: 1930, last: 2029, wantErr: nil},
+		{name: "more than a century", first: 1930, last: 2030, wantErr: dte.ErrYearWindow},
+		{name: "reversed", first: 2030, last: 1930, wantErr: dte.ErrYearWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dte.NewYearWindow(tt.first, tt.last)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewYearWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := dte.SlidingYearWindow(now, -1, 10)
+	if !errors.Is(err, dte.ErrYearWindow) {
+		t.Errorf("SlidingYearWindow() error = %v, wantErr %v", err, dte.ErrYearWindow)
+	}
+
+	_, err = dte.FixedYearWindow(1950).Year(100)
+	if !errors.Is(err, dte.ErrYearOutsideWindow) {
+		t.Errorf("Year() error = %v, wantErr %v", err, dte.ErrYearOutsideWindow)
+	}
+}
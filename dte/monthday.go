@@ -0,0 +1,185 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrMonthDayParse = errors.New("month day does not follow the --mm-dd or mm-dd format")
+	ErrMonthDayRange = errors.New("month day is not a day of the month")
+)
+
+// LeapDayPolicy is the date February 29th falls on in years without one.
+type LeapDayPolicy int
+
+const (
+	// LeapDayToFebruary28 moves February 29th to February 28th in common years.
+	LeapDayToFebruary28 LeapDayPolicy = iota
+	// LeapDayToMarch1 moves February 29th to March 1st in common years.
+	LeapDayToMarch1
+)
+
+const (
+	monthDayOnly = "--01-02"
+	// leapYear is a year with February 29th, used to validate month days and format them with a time layout.
+	leapYear = 2000
+	leapDay  = 29
+)
+
+// MonthDay is a day of the year without a year, such as a birthday, an anniversary or a fixed holiday, in the
+// xsd:gMonthDay --mm-dd format. February 29th is allowed and OnYear maps it to a date in common years. Its zero
+// value is no day at all.
+type MonthDay struct { //nolint:recvcheck
+	month time.Month
+	day   int
+}
+
+// NewMonthDay returns the day of the month, which may be up to 29 for February.
+func NewMonthDay(month time.Month, day int) (MonthDay, error) {
+	onLeapYear := time.Date(leapYear, month, day, 0, 0, 0, 0, time.UTC)
+	if month < time.January || month > time.December || day < 1 || onLeapYear.Month() != month {
+		return MonthDay{}, fmt.Errorf("%w: --%02d-%02d", ErrMonthDayRange, month, day)
+	}
+
+	return MonthDay{month: month, day: day}, nil
+}
+
+// MonthDayOf returns the month and day of the date.
+func MonthDayOf(d Date) MonthDay {
+	return MonthDay{month: d.Month(), day: d.Day()}
+}
+
+// ParseMonthDay parses a month day in the xsd:gMonthDay --mm-dd format or the shorter mm-dd format.
+func ParseMonthDay(s string) (MonthDay, error) {
+	for _, pattern := range []string{"--MM-DD", "MM-DD"} {
+		fields, ok := matchISOPattern(s, pattern)
+		if !ok {
+			continue
+		}
+
+		return NewMonthDay(time.Month(fields['M']), fields['D'])
+	}
+
+	return MonthDay{}, fmt.Errorf("%w: %q", ErrMonthDayParse, s)
+}
+
+// IsZero reports whether m is the zero MonthDay.
+func (m MonthDay) IsZero() bool {
+	return m.month == 0
+}
+
+// Month returns the month.
+func (m MonthDay) Month() time.Month {
+	return m.month
+}
+
+// Day returns the day of the month.
+func (m MonthDay) Day() int {
+	return m.day
+}
+
+// IsLeapDay reports whether m is February 29th.
+func (m MonthDay) IsLeapDay() bool {
+	return m.month == time.February && m.day == leapDay
+}
+
+// OnYear returns the date of m in the year. February 29th falls on the date of the policy in common years.
+func (m MonthDay) OnYear(year int, policy LeapDayPolicy) Date {
+	onYear := time.Date(year, m.month, m.day, 0, 0, 0, 0, time.UTC)
+	if onYear.Month() != m.month && policy == LeapDayToFebruary28 {
+		onYear = onYear.AddDate(0, 0, -1)
+	}
+
+	return Date{onYear}
+}
+
+// NextAfter returns the first date of m after d, so a birthday on d itself is next in the following year.
+func (m MonthDay) NextAfter(d Date, policy LeapDayPolicy) Date {
+	next := m.OnYear(d.Year(), policy)
+
+	year, month, day := d.Date()
+	if !next.After(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)) {
+		next = m.OnYear(d.Year()+1, policy)
+	}
+
+	return next
+}
+
+// Compare returns -1 when m is earlier in the year than other, 1 when it is later and 0 when both are the same day.
+func (m MonthDay) Compare(other MonthDay) int {
+	switch {
+	case m.month < other.month || m.month == other.month && m.day < other.day:
+		return -1
+	case m == other:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Before reports whether m is earlier in the year than other.
+func (m MonthDay) Before(other MonthDay) bool {
+	return m.Compare(other) < 0
+}
+
+// After reports whether m is later in the year than other.
+func (m MonthDay) After(other MonthDay) bool {
+	return m.Compare(other) > 0
+}
+
+// Format formats m with a time layout, for example "January 2". The layout must not print the year.
+func (m MonthDay) Format(layout string) string {
+	return time.Date(leapYear, m.month, m.day, 0, 0, 0, 0, time.UTC).Format(layout)
+}
+
+// String returns the month day in the --mm-dd format, or an empty string for the zero MonthDay.
+func (m MonthDay) String() string {
+	if m.IsZero() {
+		return ""
+	}
+
+	return m.Format(monthDayOnly)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The month day is a quoted string in the --mm-dd format, or null for the zero MonthDay.
+func (m MonthDay) MarshalJSON() ([]byte, error) {
+	if m.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + m.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The month day must be a quoted string in the --mm-dd or mm-dd format, null leaves it unchanged.
+func (m *MonthDay) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("MonthDay.UnmarshalJSON: %w: input is not a JSON string", ErrMonthDayParse)
+	}
+
+	return m.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (m MonthDay) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (m *MonthDay) UnmarshalText(data []byte) error {
+	parsed, err := ParseMonthDay(string(data))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleMonthDay_NextAfter() {
+	birthday, err := dte.ParseMonthDay("--02-29")
+	if err != nil {
+		return
+	}
+
+	today, err := dte.NewDate("2025-01-15")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(
+		birthday.NextAfter(today, dte.LeapDayToFebruary28),
+		birthday.NextAfter(today, dte.LeapDayToMarch1),
+		birthday.OnYear(2028, dte.LeapDayToMarch1),
+		birthday.Format("January 2"),
+	)
+
+	// Output: 2025-02-28 2025-03-01 2028-02-29 February 29
+}
+
+func ExampleMonthDay_json() {
+	type Reminder struct {
+		Anniversary dte.MonthDay `json:"anniversary"`
+	}
+
+	var reminder Reminder
+
+	err := json.Unmarshal([]byte(`{"anniversary":"06-15"}`), &reminder)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(reminder)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled))
+
+	// Output: {"anniversary":"--06-15"}
+}
+
+func TestParseMonthDay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr error
+	}{
+		{input: "--02-29", want: "--02-29"},
+		{input: "--12-31", want: "--12-31"},
+		{input: "01-01", want: "--01-01"},
+		{input: "--02-30", wantErr: dte.ErrMonthDayRange},
+		{input: "--04-31", wantErr: dte.ErrMonthDayRange},
+		{input: "--13-01", wantErr: dte.ErrMonthDayRange},
+		{input: "--00-10", wantErr: dte.ErrMonthDayRange},
+		{input: "--01-00", wantErr: dte.ErrMonthDayRange},
+		{input: "--1-1", wantErr: dte.ErrMonthDayParse},
+		{input: "2024-02-29", wantErr: dte.ErrMonthDayParse},
+		{input: "", wantErr: dte.ErrMonthDayParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseMonthDay(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMonthDay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("ParseMonthDay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthDayOnYear(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		monthDay string
+		after    string
+		policy   dte.LeapDayPolicy
+		wantOn   string
+		wantNext string
+	}{
+		{
+			name:     "later this year",
+			monthDay: "--07-04",
+			after:    "2025-03-01",
+			policy:   dte.LeapDayToFebruary28,
+			wantOn:   "2025-07-04",
+			wantNext: "2025-07-04",
+		},
+		{
+			name:     "same day is next year",
+			monthDay: "--07-04",
+			after:    "2025-07-04",
+			policy:   dte.LeapDayToFebruary28,
+			wantOn:   "2025-07-04",
+			wantNext: "2026-07-04",
+		},
+		{
+			name:     "leap day on a leap year",
+			monthDay: "--02-29",
+			after:    "2024-01-01",
+			policy:   dte.LeapDayToMarch1,
+			wantOn:   "2024-02-29",
+			wantNext: "2024-02-29",
+		},
+		{
+			name:     "leap day moved to february 28",
+			monthDay: "--02-29",
+			after:    "2024-02-29",
+			policy:   dte.LeapDayToFebruary28,
+			wantOn:   "2024-02-29",
+			wantNext: "2025-02-28",
+		},
+		{
+			name:     "leap day moved to march 1",
+			monthDay: "--02-29",
+			after:    "2023-03-01",
+			policy:   dte.LeapDayToMarch1,
+			wantOn:   "2023-03-01",
+			wantNext: "2024-02-29",
+		},
+		{
+			name:     "february 28 is not moved",
+			monthDay: "--02-28",
+			after:    "2023-02-27",
+			policy:   dte.LeapDayToMarch1,
+			wantOn:   "2023-02-28",
+			wantNext: "2023-02-28",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			monthDay, err := dte.ParseMonthDay(tt.monthDay)
+			if err != nil {
+				t.Fatalf("ParseMonthDay() error = %v", err)
+			}
+
+			after, err := dte.NewDate(tt.after)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			if got := monthDay.OnYear(after.Year(), tt.policy); got.String() != tt.wantOn {
+				t.Errorf("OnYear() = %v, want %v", got, tt.wantOn)
+			}
+
+			if got := monthDay.NextAfter(after, tt.policy); got.String() != tt.wantNext {
+				t.Errorf("NextAfter() = %v, want %v", got, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestMonthDayCompare(t *testing.T) {
+	t.Parallel()
+
+	onlyDate, err := dte.NewDate("2024-02-29")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	leapDay := dte.MonthDayOf(onlyDate)
+
+	newYear, err := dte.NewMonthDay(time.January, 1)
+	if err != nil {
+		t.Fatalf("NewMonthDay() error = %v", err)
+	}
+
+	if !leapDay.IsLeapDay() || !newYear.Before(leapDay) || !leapDay.After(newYear) || leapDay.Compare(leapDay) != 0 {
+		t.Errorf("Compare() = %v", newYear.Compare(leapDay))
+	}
+
+	if leapDay.Month() != time.February || leapDay.Day() != 29 || newYear.IsLeapDay() {
+		t.Errorf("MonthDayOf() = %v", leapDay)
+	}
+
+	var zero dte.MonthDay
+
+	marshaled, err := json.Marshal(zero)
+	if err != nil || string(marshaled) != "null" || !zero.IsZero() {
+		t.Errorf("Marshal() = %s, %v, want null", marshaled, err)
+	}
+
+	err = json.Unmarshal([]byte(`229`), &zero)
+	if !errors.Is(err, dte.ErrMonthDayParse) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, dte.ErrMonthDayParse)
+	}
+}
@@ -0,0 +1,226 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrPartialDateParse     = errors.New("partial date does not follow the yyyy, yyyy-mm or yyyy-mm-dd format")
+	ErrPartialDatePrecision = errors.New("unknown partial date precision")
+)
+
+// PartialDatePrecision is the finest component a PartialDate specifies.
+type PartialDatePrecision int
+
+const (
+	// PartialDatePrecisionYear is a year only partial date such as 2019. It starts at one so the zero PartialDate
+	// has no precision.
+	PartialDatePrecisionYear PartialDatePrecision = iota + 1
+	PartialDatePrecisionMonth
+	PartialDatePrecisionDay
+)
+
+const yearOnly = "2006"
+
+// PartialDate is a date that may only specify its year or its year and month, as the FHIR and HL7 date types allow
+// for birth and onset dates. It covers every day from Start to End and its zero value is no date at all.
+type PartialDate struct { //nolint:recvcheck
+	start     Date
+	precision PartialDatePrecision
+}
+
+// NewPartialDate returns the partial date of d cut to the precision, so the month and day of d are ignored for
+// PartialDatePrecisionYear.
+func NewPartialDate(d Date, precision PartialDatePrecision) (PartialDate, error) {
+	year, month, day := d.Date()
+
+	switch precision {
+	case PartialDatePrecisionYear:
+		month, day = time.January, 1
+	case PartialDatePrecisionMonth:
+		day = 1
+	case PartialDatePrecisionDay:
+	default:
+		return PartialDate{}, fmt.Errorf("%w: %d", ErrPartialDatePrecision, precision)
+	}
+
+	return PartialDate{start: Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}, precision: precision}, nil
+}
+
+// ParsePartialDate parses a yyyy, yyyy-mm or yyyy-mm-dd partial date, the format of the FHIR date type. Years run
+// from 0001 to 9999.
+func ParsePartialDate(s string) (PartialDate, error) {
+	var (
+		layout    string
+		precision PartialDatePrecision
+	)
+
+	switch len(s) {
+	case len(yearOnly):
+		layout, precision = yearOnly, PartialDatePrecisionYear
+	case len(YearMonthOnly):
+		layout, precision = YearMonthOnly, PartialDatePrecisionMonth
+	case len(DateOnly):
+		layout, precision = DateOnly, PartialDatePrecisionDay
+	default:
+		return PartialDate{}, fmt.Errorf("%w: %q", ErrPartialDateParse, s)
+	}
+
+	for _, r := range s[:len(yearOnly)] {
+		if r < '0' || r > '9' {
+			return PartialDate{}, fmt.Errorf("%w: %q", ErrPartialDateParse, s)
+		}
+	}
+
+	parsed, err := time.Parse(layout, s)
+	if err != nil {
+		return PartialDate{}, fmt.Errorf("%w: %w", ErrPartialDateParse, err)
+	}
+
+	if parsed.Year() < 1 {
+		return PartialDate{}, fmt.Errorf("%w: %q has year 0000", ErrPartialDateParse, s)
+	}
+
+	return PartialDate{start: Date{parsed}, precision: precision}, nil
+}
+
+// IsZero reports whether p is the zero PartialDate.
+func (p PartialDate) IsZero() bool {
+	return p.precision == 0
+}
+
+// Precision returns the finest component of the partial date, or zero for the zero PartialDate.
+func (p PartialDate) Precision() PartialDatePrecision {
+	return p.precision
+}
+
+// Year returns the year of the partial date.
+func (p PartialDate) Year() int {
+	return p.start.Year()
+}
+
+// Month returns the month of the partial date and whether it is specified.
+func (p PartialDate) Month() (time.Month, bool) {
+	if p.precision < PartialDatePrecisionMonth {
+		return 0, false
+	}
+
+	return p.start.Month(), true
+}
+
+// Day returns the day of the month of the partial date and whether it is specified.
+func (p PartialDate) Day() (int, bool) {
+	if p.precision < PartialDatePrecisionDay {
+		return 0, false
+	}
+
+	return p.start.Day(), true
+}
+
+// Start returns the first day the partial date covers, so 2019-04 starts at 2019-04-01.
+func (p PartialDate) Start() Date {
+	return p.start
+}
+
+// End returns the last day the partial date covers, so 2019-04 ends at 2019-04-30.
+func (p PartialDate) End() Date {
+	switch p.precision {
+	case PartialDatePrecisionYear:
+		return Date{p.start.AddDate(1, 0, -1)}
+	case PartialDatePrecisionMonth:
+		return Date{p.start.AddDate(0, 1, -1)}
+	default:
+		return p.start
+	}
+}
+
+// Contains reports whether the date is one of the days the partial date covers.
+func (p PartialDate) Contains(d Date) bool {
+	if p.IsZero() {
+		return false
+	}
+
+	year, month, day := d.Date()
+	onlyDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	return !onlyDate.Before(p.start.Time) && !onlyDate.After(p.End().Time)
+}
+
+// Equal reports whether p and other have the same precision and the same value, so 2019 does not equal 2019-01.
+func (p PartialDate) Equal(other PartialDate) bool {
+	return p.precision == other.precision && p.start.Equal(other.start.Time)
+}
+
+// Compare compares the days p and other cover. It returns -1 when every day of p is before every day of other, 1
+// when every day of p is after every day of other and 0 when both are Equal. Otherwise the order is unknown, as for
+// 2019 and 2019-04, and ok is false.
+func (p PartialDate) Compare(other PartialDate) (int, bool) {
+	switch {
+	case p.Equal(other):
+		return 0, true
+	case p.End().Before(other.start.Time):
+		return -1, true
+	case p.start.After(other.End().Time):
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// String returns the partial date in the yyyy, yyyy-mm or yyyy-mm-dd format, or an empty string for the zero
+// PartialDate.
+func (p PartialDate) String() string {
+	switch p.precision {
+	case PartialDatePrecisionYear:
+		return p.start.Format(yearOnly)
+	case PartialDatePrecisionMonth:
+		return p.start.Format(YearMonthOnly)
+	case PartialDatePrecisionDay:
+		return p.start.Format(DateOnly)
+	default:
+		return ""
+	}
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The partial date is a quoted string in the yyyy, yyyy-mm or yyyy-mm-dd format, or null for the zero PartialDate.
+func (p PartialDate) MarshalJSON() ([]byte, error) {
+	if p.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + p.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The partial date must be a quoted string in the yyyy, yyyy-mm or yyyy-mm-dd format, null leaves it unchanged.
+func (p *PartialDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("PartialDate.UnmarshalJSON: %w: input is not a JSON string", ErrPartialDateParse)
+	}
+
+	return p.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (p PartialDate) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (p *PartialDate) UnmarshalText(data []byte) error {
+	parsed, err := ParsePartialDate(string(data))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParsePartialDate() {
+	birth, err := dte.ParsePartialDate("2019-04")
+	if err != nil {
+		return
+	}
+
+	onset, err := dte.ParsePartialDate("2019")
+	if err != nil {
+		return
+	}
+
+	order, ok := birth.Compare(onset)
+
+	fmt.Println(birth, birth.Start(), birth.End(), order, ok)
+
+	// Output: 2019-04 2019-04-01 2019-04-30 0 false
+}
+
+func ExamplePartialDate_json() {
+	type Patient struct {
+		BirthDate dte.PartialDate `json:"birthDate"`
+		Deceased  dte.PartialDate `json:"deceased"`
+	}
+
+	var patient Patient
+
+	err := json.Unmarshal([]byte(`{"birthDate":"1974","deceased":null}`), &patient)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(patient)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled), patient.BirthDate.Precision() == dte.PartialDatePrecisionYear)
+
+	// Output: {"birthDate":"1974","deceased":null} true
+}
+
+func TestParsePartialDate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		year  = dte.PartialDatePrecisionYear
+		month = dte.PartialDatePrecisionMonth
+		day   = dte.PartialDatePrecisionDay
+	)
+
+	tests := []struct {
+		name          string
+		input         string
+		wantPrecision dte.PartialDatePrecision
+		wantStart     string
+		wantEnd       string
+		wantErr       error
+	}{
+		{name: "year", input: "2019", wantPrecision: year, wantStart: "2019-01-01", wantEnd: "2019-12-31"},
+		{name: "month", input: "2024-02", wantPrecision: month, wantStart: "2024-02-01", wantEnd: "2024-02-29"},
+		{name: "day", input: "2019-04-12", wantPrecision: day, wantStart: "2019-04-12", wantEnd: "2019-04-12"},
+		{name: "first year", input: "0001", wantPrecision: year, wantStart: "0001-01-01", wantEnd: "0001-12-31"},
+		{name: "year zero", input: "0000", wantErr: dte.ErrPartialDateParse},
+		{name: "signed year", input: "+201", wantErr: dte.ErrPartialDateParse},
+		{name: "short year", input: "201", wantErr: dte.ErrPartialDateParse},
+		{name: "month 13", input: "2019-13", wantErr: dte.ErrPartialDateParse},
+		{name: "single digit month", input: "2019-4", wantErr: dte.ErrPartialDateParse},
+		{name: "invalid day", input: "2019-02-29", wantErr: dte.ErrPartialDateParse},
+		{name: "date and time", input: "2019-04-12T10:00:00Z", wantErr: dte.ErrPartialDateParse},
+		{name: "empty", input: "", wantErr: dte.ErrPartialDateParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParsePartialDate(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePartialDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.String() != tt.input || got.Precision() != tt.wantPrecision {
+				t.Errorf("ParsePartialDate() = %v with precision %v, want %v", got, got.Precision(), tt.wantPrecision)
+			}
+
+			if got.Start().String() != tt.wantStart || got.End().String() != tt.wantEnd {
+				t.Errorf("Start(), End() = %v, %v, want %v, %v", got.Start(), got.End(), tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestNewPartialDate(t *testing.T) {
+	t.Parallel()
+
+	onlyDate, err := dte.NewDate("2019-04-12")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	tests := []struct {
+		precision dte.PartialDatePrecision
+		want      string
+		wantMonth bool
+		wantDay   bool
+	}{
+		{precision: dte.PartialDatePrecisionYear, want: "2019"},
+		{precision: dte.PartialDatePrecisionMonth, want: "2019-04", wantMonth: true},
+		{precision: dte.PartialDatePrecisionDay, want: "2019-04-12", wantMonth: true, wantDay: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.NewPartialDate(onlyDate, tt.precision)
+			if err != nil {
+				t.Fatalf("NewPartialDate() error = %v", err)
+			}
+
+			_, hasMonth := got.Month()
+			_, hasDay := got.Day()
+
+			if got.String() != tt.want || got.Year() != 2019 || hasMonth != tt.wantMonth || hasDay != tt.wantDay {
+				t.Errorf("NewPartialDate() = %v, month %v, day %v", got, hasMonth, hasDay)
+			}
+		})
+	}
+
+	_, err = dte.NewPartialDate(onlyDate, 0)
+	if !errors.Is(err, dte.ErrPartialDatePrecision) {
+		t.Errorf("NewPartialDate() error = %v, wantErr %v", err, dte.ErrPartialDatePrecision)
+	}
+}
+
+func TestPartialDateCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a         string
+		b         string
+		wantOrder int
+		wantOK    bool
+		wantEqual bool
+	}{
+		{a: "2019", b: "2019", wantOrder: 0, wantOK: true, wantEqual: true},
+		{a: "2019", b: "2019-01", wantOrder: 0, wantOK: false},
+		{a: "2019-04", b: "2019-04-30", wantOrder: 0, wantOK: false},
+		{a: "2018", b: "2019-01-01", wantOrder: -1, wantOK: true},
+		{a: "2019-05", b: "2019-04-30", wantOrder: 1, wantOK: true},
+		{a: "2019-04-12", b: "2019-04-13", wantOrder: -1, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			t.Parallel()
+
+			a, err := dte.ParsePartialDate(tt.a)
+			if err != nil {
+				t.Fatalf("ParsePartialDate() error = %v", err)
+			}
+
+			b, err := dte.ParsePartialDate(tt.b)
+			if err != nil {
+				t.Fatalf("ParsePartialDate() error = %v", err)
+			}
+
+			order, ok := a.Compare(b)
+			if order != tt.wantOrder || ok != tt.wantOK {
+				t.Errorf("Compare() = %v, %v, want %v, %v", order, ok, tt.wantOrder, tt.wantOK)
+			}
+
+			reverse, reverseOK := b.Compare(a)
+			if reverse != -tt.wantOrder || reverseOK != tt.wantOK {
+				t.Errorf("reverse Compare() = %v, %v", reverse, reverseOK)
+			}
+
+			if a.Equal(b) != tt.wantEqual {
+				t.Errorf("Equal() = %v, want %v", a.Equal(b), tt.wantEqual)
+			}
+
+			if a.Contains(b.Start()) != (tt.wantOrder == 0) {
+				t.Errorf("Contains(%v) = %v", b.Start(), a.Contains(b.Start()))
+			}
+		})
+	}
+}
+
+func TestPartialDateJSON(t *testing.T) {
+	t.Parallel()
+
+	var partial dte.PartialDate
+
+	for _, input := range []string{`2019`, `"2019-04-12T00:00:00Z"`, `"19-04"`} {
+		err := json.Unmarshal([]byte(input), &partial)
+		if !errors.Is(err, dte.ErrPartialDateParse) {
+			t.Errorf("Unmarshal(%s) error = %v, wantErr %v", input, err, dte.ErrPartialDateParse)
+		}
+	}
+
+	if !partial.IsZero() || partial.String() != "" || partial.Contains(dte.Date{}) {
+		t.Errorf("PartialDate = %v, want zero", partial)
+	}
+}
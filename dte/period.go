@@ -0,0 +1,238 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrPeriodParse = errors.New("period does not follow the ISO 8601 PnYnMnWnD format")
+
+// MonthEndPolicy is how adding months to a date handles a day that the resulting month does not have.
+type MonthEndPolicy int
+
+const (
+	// MonthEndClamp moves a day past the end of the month back to its last day, so 2024-01-31 plus one month is
+	// 2024-02-29.
+	MonthEndClamp MonthEndPolicy = iota
+	// MonthEndOverflow carries a day past the end of the month into the next month as time.Time.AddDate does, so
+	// 2024-01-31 plus one month is 2024-03-02.
+	MonthEndOverflow
+	// MonthEndSticky keeps the last day of a month on the last day, so 2024-04-30 plus one month is 2024-05-31, and
+	// clamps other days.
+	MonthEndSticky
+)
+
+// Period is an amount of calendar time in years, months, weeks and days, such as the P1Y2M10D of an ISO 8601
+// duration. Unlike time.Duration the length of a period depends on the date it is added to. Components may be
+// negative and are kept as given until Normalized.
+type Period struct {
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+}
+
+// ParsePeriod parses an ISO 8601 duration with date components only, such as P1Y2M10D, P2W or -P1M. Each component
+// may carry its own sign, as in P1Y-2M, and weeks may be combined with the other components.
+func ParsePeriod(s string) (Period, error) {
+	text, negative := strings.CutPrefix(s, "-")
+	if !negative {
+		text = strings.TrimPrefix(text, "+")
+	}
+
+	text, ok := strings.CutPrefix(text, "P")
+	if !ok || text == "" {
+		return Period{}, fmt.Errorf("%w: %q", ErrPeriodParse, s)
+	}
+
+	var period Period
+
+	units := []struct {
+		designator byte
+		value      *int
+	}{
+		{designator: 'Y', value: &period.Years},
+		{designator: 'M', value: &period.Months},
+		{designator: 'W', value: &period.Weeks},
+		{designator: 'D', value: &period.Days},
+	}
+
+	for _, unit := range units {
+		i := strings.IndexByte(text, unit.designator)
+		if i < 0 {
+			continue
+		}
+
+		value, err := strconv.Atoi(text[:i])
+		if err != nil || i == 0 {
+			return Period{}, fmt.Errorf("%w: %q", ErrPeriodParse, s)
+		}
+
+		*unit.value, text = value, text[i+1:]
+	}
+
+	if text != "" {
+		return Period{}, fmt.Errorf("%w: %q has %q left over", ErrPeriodParse, s, text)
+	}
+
+	if negative {
+		period = period.Negated()
+	}
+
+	return period, nil
+}
+
+// PeriodBetween returns the period from a to b in years, months and days, negative when b is before a. The days
+// are counted from a plus the whole months, so PeriodBetween(2024-01-31, 2024-03-01) is P1M1D and adding the result
+// to a with MonthEndClamp gives b.
+func PeriodBetween(a Date, b Date) Period {
+	startYear, startMonth, startDay := a.Date()
+	endYear, endMonth, endDay := b.Date()
+
+	months := (endYear-startYear)*monthsPerYear + int(endMonth-startMonth)
+	days := endDay - startDay
+
+	switch {
+	case months > 0 && days < 0:
+		months--
+		days = int(unixDays(b) - unixDays(addMonths(a, months, MonthEndClamp)))
+	case months < 0:
+		// A month end of a may clamp to an earlier day, so the days are counted from a minus the whole months.
+		days = int(unixDays(b) - unixDays(addMonths(a, months, MonthEndClamp)))
+		if days > 0 {
+			months++
+			days = int(unixDays(b) - unixDays(addMonths(a, months, MonthEndClamp)))
+		}
+	}
+
+	return Period{Years: months / monthsPerYear, Months: months % monthsPerYear, Weeks: 0, Days: days}
+}
+
+// IsZero reports whether every component of p is zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negated returns p with every component negated.
+func (p Period) Negated() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days}
+}
+
+// Plus returns the sum of p and other, component by component.
+func (p Period) Plus(other Period) Period {
+	return Period{
+		Years:  p.Years + other.Years,
+		Months: p.Months + other.Months,
+		Weeks:  p.Weeks + other.Weeks,
+		Days:   p.Days + other.Days,
+	}
+}
+
+// Normalized returns p with the months carried into years, so the months are between -11 and 11 with the sign of
+// the years, and the weeks folded into days. The length of a month stays unknown, so days are never carried into
+// months.
+func (p Period) Normalized() Period {
+	months := p.Years*monthsPerYear + p.Months
+
+	return Period{
+		Years:  months / monthsPerYear,
+		Months: months % monthsPerYear,
+		Weeks:  0,
+		Days:   p.Weeks*daysPerWeek + p.Days,
+	}
+}
+
+// AddTo returns the date p after d. Years and months are added first, with the policy handling days past the end
+// of the resulting month, and then weeks and days.
+func (p Period) AddTo(d Date, policy MonthEndPolicy) Date {
+	withMonths := addMonths(d, p.Years*monthsPerYear+p.Months, policy)
+
+	return Date{withMonths.AddDate(0, 0, p.Weeks*daysPerWeek+p.Days)}
+}
+
+// String returns p as an ISO 8601 duration, such as P1Y2M10D, leaving out zero components. A period whose
+// components are all zero or negative is written with a single leading minus, as in -P1M, and the zero period is
+// P0D.
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	sign := ""
+	if p.Years <= 0 && p.Months <= 0 && p.Weeks <= 0 && p.Days <= 0 {
+		sign, p = "-", p.Negated()
+	}
+
+	var b strings.Builder
+
+	b.WriteString(sign + "P")
+
+	for _, unit := range []struct {
+		value      int
+		designator string
+	}{{p.Years, "Y"}, {p.Months, "M"}, {p.Weeks, "W"}, {p.Days, "D"}} {
+		if unit.value != 0 {
+			b.WriteString(strconv.Itoa(unit.value) + unit.designator)
+		}
+	}
+
+	return b.String()
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The period is a quoted ISO 8601 duration.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + p.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The period must be a quoted ISO 8601 duration with date components only, null leaves it unchanged.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("Period.UnmarshalJSON: %w: input is not a JSON string", ErrPeriodParse)
+	}
+
+	return p.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (p *Period) UnmarshalText(data []byte) error {
+	parsed, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+
+	return nil
+}
+
+// addMonths returns the date n months after d, with the policy handling days past the end of the resulting month.
+func addMonths(d Date, n int, policy MonthEndPolicy) Date {
+	if policy == MonthEndOverflow {
+		return Date{d.AddDate(0, n, 0)}
+	}
+
+	month := YearMonthOf(d).AddMonths(n)
+
+	day := d.Day()
+	if day > month.Days() || policy == MonthEndSticky && day == YearMonthOf(d).Days() {
+		day = month.Days()
+	}
+
+	hour, minute, second := d.Clock()
+
+	return Date{time.Date(month.Year(), month.Month(), day, hour, minute, second, d.Nanosecond(), d.Location())}
+}
@@ -0,0 +1,212 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParsePeriod() {
+	term, err := dte.ParsePeriod("P1Y2M10D")
+	if err != nil {
+		return
+	}
+
+	start, err := dte.NewDate("2024-01-31")
+	if err != nil {
+		return
+	}
+
+	end := term.AddTo(start, dte.MonthEndClamp)
+
+	fmt.Println(term, end, dte.PeriodBetween(start, end), term.Negated(), dte.Period{Months: 14}.Normalized())
+
+	// Output: P1Y2M10D 2025-04-10 P1Y2M10D -P1Y2M10D P1Y2M
+}
+
+func ExamplePeriod_json() {
+	type Contract struct {
+		Term dte.Period `json:"term"`
+	}
+
+	var contract Contract
+
+	err := json.Unmarshal([]byte(`{"term":"P2W"}`), &contract)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(contract)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled), contract.Term.Normalized())
+
+	// Output: {"term":"P2W"} P14D
+}
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input      string
+		want       dte.Period
+		wantString string
+		wantErr    error
+	}{
+		{input: "P1Y2M10D", want: dte.Period{Years: 1, Months: 2, Days: 10}, wantString: "P1Y2M10D"},
+		{input: "P2W", want: dte.Period{Weeks: 2}, wantString: "P2W"},
+		{input: "P1Y3W", want: dte.Period{Years: 1, Weeks: 3}, wantString: "P1Y3W"},
+		{input: "-P1M", want: dte.Period{Months: -1}, wantString: "-P1M"},
+		{input: "+P1D", want: dte.Period{Days: 1}, wantString: "P1D"},
+		{input: "P1Y-2M", want: dte.Period{Years: 1, Months: -2}, wantString: "P1Y-2M"},
+		{input: "-P1Y-2M", want: dte.Period{Years: -1, Months: 2}, wantString: "P-1Y2M"},
+		{input: "P0D", want: dte.Period{}, wantString: "P0D"},
+		{input: "P", wantErr: dte.ErrPeriodParse},
+		{input: "P1M1Y", wantErr: dte.ErrPeriodParse},
+		{input: "PT1H", wantErr: dte.ErrPeriodParse},
+		{input: "P1.5Y", wantErr: dte.ErrPeriodParse},
+		{input: "PY", wantErr: dte.ErrPeriodParse},
+		{input: "1Y", wantErr: dte.ErrPeriodParse},
+		{input: "", wantErr: dte.ErrPeriodParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParsePeriod(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got != tt.want || got.String() != tt.wantString {
+				t.Errorf("ParsePeriod() = %+v written %v, want %+v written %v", got, got, tt.want, tt.wantString)
+			}
+		})
+	}
+}
+
+func TestPeriodAddTo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		clamp    = dte.MonthEndClamp
+		overflow = dte.MonthEndOverflow
+		sticky   = dte.MonthEndSticky
+	)
+
+	tests := []struct {
+		name   string
+		date   string
+		period dte.Period
+		policy dte.MonthEndPolicy
+		want   string
+	}{
+		{name: "clamp", date: "2024-01-31", period: dte.Period{Months: 1}, policy: clamp, want: "2024-02-29"},
+		{name: "overflow", date: "2024-01-31", period: dte.Period{Months: 1}, policy: overflow, want: "2024-03-02"},
+		{name: "sticky", date: "2024-04-30", period: dte.Period{Months: 1}, policy: sticky, want: "2024-05-31"},
+		{name: "sticky clamps", date: "2024-01-30", period: dte.Period{Months: 1}, policy: sticky, want: "2024-02-29"},
+		{name: "leap year", date: "2024-02-29", period: dte.Period{Years: 1}, policy: clamp, want: "2025-02-28"},
+		{name: "months then days", date: "2024-01-31", period: dte.Period{Months: 1, Days: 1}, want: "2024-03-01"},
+		{name: "weeks", date: "2024-12-25", period: dte.Period{Weeks: 2}, want: "2025-01-08"},
+		{name: "negative", date: "2024-03-31", period: dte.Period{Months: -1, Days: -1}, want: "2024-02-28"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.date)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			if got := tt.period.AddTo(onlyDate, tt.policy); got.String() != tt.want {
+				t.Errorf("AddTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		start string
+		end   string
+		want  string
+	}{
+		{start: "2024-01-15", end: "2025-03-20", want: "P1Y2M5D"},
+		{start: "2024-01-31", end: "2024-03-01", want: "P1M1D"},
+		{start: "2024-01-31", end: "2024-02-29", want: "P29D"},
+		{start: "2024-03-20", end: "2024-03-20", want: "P0D"},
+		{start: "2025-03-20", end: "2024-01-15", want: "-P1Y2M5D"},
+		{start: "2024-03-01", end: "2024-01-31", want: "-P1M1D"},
+		{start: "2024-05-31", end: "2024-04-30", want: "-P1M"},
+		{start: "2024-03-31", end: "2024-02-01", want: "-P1M28D"},
+		{start: "2023-03-31", end: "2023-02-28", want: "-P1M"},
+		{start: "2024-03-31", end: "2023-02-27", want: "-P1Y1M1D"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.start+" "+tt.end, func(t *testing.T) {
+			t.Parallel()
+
+			start, err := dte.NewDate(tt.start)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			end, err := dte.NewDate(tt.end)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			got := dte.PeriodBetween(start, end)
+			if got.String() != tt.want {
+				t.Errorf("PeriodBetween() = %v, want %v", got, tt.want)
+			}
+
+			if added := got.AddTo(start, dte.MonthEndClamp); added.String() != tt.end {
+				t.Errorf("AddTo(PeriodBetween()) = %v, want %v", added, tt.end)
+			}
+		})
+	}
+}
+
+func TestPeriodNormalized(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		period dte.Period
+		want   dte.Period
+	}{
+		{period: dte.Period{Months: 14, Weeks: 1, Days: 2}, want: dte.Period{Years: 1, Months: 2, Days: 9}},
+		{period: dte.Period{Years: 1, Months: -14}, want: dte.Period{Months: -2}},
+		{period: dte.Period{Years: -1, Months: -13}, want: dte.Period{Years: -2, Months: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period.String(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.period.Normalized(); got != tt.want {
+				t.Errorf("Normalized() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	sum := dte.Period{Years: 1, Days: 1}.Plus(dte.Period{Months: 1, Days: -1})
+	if sum != (dte.Period{Years: 1, Months: 1}) || sum.IsZero() {
+		t.Errorf("Plus() = %v", sum)
+	}
+}
@@ -0,0 +1,153 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrQuarterParse = errors.New("quarter does not follow the yyyy-Qn, yyyyQn or Qn yyyy format")
+
+// Quarter is a quarter of a fiscal year, such as 2024-Q3. Quarters of the zero FiscalCalendar are calendar quarters,
+// 2024-Q3 running from 2024-07-01 to 2024-09-30. Its zero value is no quarter at all.
+type Quarter struct { //nolint:recvcheck
+	period fiscalPeriod
+}
+
+// NewQuarter returns the quarter, 1 to 4, of the calendar year.
+func NewQuarter(year int, quarter int) (Quarter, error) {
+	return FiscalCalendar{}.Quarter(year, quarter)
+}
+
+// QuarterOf returns the calendar quarter the date is in.
+func QuarterOf(d Date) Quarter {
+	return FiscalCalendar{}.QuarterOf(d)
+}
+
+// ParseQuarter parses a calendar quarter in the yyyy-Qn, yyyyQn or "Qn yyyy" format.
+func ParseQuarter(s string) (Quarter, error) {
+	return FiscalCalendar{}.ParseQuarter(s)
+}
+
+// ParseQuarter parses a quarter of the fiscal calendar in the yyyy-Qn, yyyyQn or "Qn yyyy" format, where yyyy is the
+// fiscal year.
+func (c FiscalCalendar) ParseQuarter(s string) (Quarter, error) {
+	period, err := c.parsePeriod(s, "Q", quartersPerYear, ErrQuarterParse)
+	if err != nil {
+		return Quarter{}, err
+	}
+
+	return Quarter{period}, nil
+}
+
+// IsZero reports whether q is the zero Quarter.
+func (q Quarter) IsZero() bool {
+	return q.period.number == 0
+}
+
+// Calendar returns the fiscal calendar of the quarter.
+func (q Quarter) Calendar() FiscalCalendar {
+	return q.period.calendar
+}
+
+// Year returns the fiscal year of the quarter.
+func (q Quarter) Year() int {
+	return q.period.year
+}
+
+// Number returns the number of the quarter in its fiscal year, 1 to 4.
+func (q Quarter) Number() int {
+	return q.period.number
+}
+
+// Start returns the first day of the quarter.
+func (q Quarter) Start() Date {
+	return q.period.start(quartersPerYear)
+}
+
+// End returns the last day of the quarter.
+func (q Quarter) End() Date {
+	return q.period.end(quartersPerYear)
+}
+
+// Contains reports whether the date is in the quarter.
+func (q Quarter) Contains(d Date) bool {
+	return q.period.contains(d, quartersPerYear)
+}
+
+// Half returns the half year the quarter is in.
+func (q Quarter) Half() Half {
+	return q.period.calendar.HalfOf(q.Start())
+}
+
+// AddQuarters returns the quarter n quarters after q, or before it for a negative n.
+func (q Quarter) AddQuarters(n int) Quarter {
+	return Quarter{q.period.add(n, quartersPerYear)}
+}
+
+// Diff returns the number of quarters from other to q, which must be of the same fiscal calendar.
+func (q Quarter) Diff(other Quarter) int {
+	return q.period.diff(other.period, quartersPerYear)
+}
+
+// Compare returns -1 when q starts before other, 1 when it starts after and 0 when both start on the same day.
+func (q Quarter) Compare(other Quarter) int {
+	return q.period.compare(other.period, quartersPerYear)
+}
+
+// Before reports whether q starts before other.
+func (q Quarter) Before(other Quarter) bool {
+	return q.Compare(other) < 0
+}
+
+// After reports whether q starts after other.
+func (q Quarter) After(other Quarter) bool {
+	return q.Compare(other) > 0
+}
+
+// String returns the quarter in the yyyy-Qn format, or an empty string for the zero Quarter.
+func (q Quarter) String() string {
+	return q.period.string("Q")
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The quarter is a quoted string in the yyyy-Qn format, or null for the zero Quarter.
+func (q Quarter) MarshalJSON() ([]byte, error) {
+	if q.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + q.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The quarter must be a quoted string in any format ParseQuarter accepts, null leaves it unchanged. The quarter is of
+// the fiscal calendar q already has, so a zero Quarter is a calendar quarter.
+func (q *Quarter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("Quarter.UnmarshalJSON: %w: input is not a JSON string", ErrQuarterParse)
+	}
+
+	return q.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (q Quarter) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. The quarter is of the fiscal calendar q already
+// has.
+func (q *Quarter) UnmarshalText(data []byte) error {
+	parsed, err := q.period.calendar.ParseQuarter(string(data))
+	if err != nil {
+		return err
+	}
+
+	*q = parsed
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseQuarter() {
+	quarter, err := dte.ParseQuarter("Q3 2024")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(quarter, quarter.Start(), quarter.End(), quarter.AddQuarters(2), quarter.Half())
+
+	// Output: 2024-Q3 2024-07-01 2024-09-30 2025-Q1 2024-H2
+}
+
+func ExampleQuarter_json() {
+	type Report struct {
+		Quarter dte.Quarter `json:"quarter"`
+	}
+
+	var report Report
+
+	err := json.Unmarshal([]byte(`{"quarter":"2024q4"}`), &report)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(report)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled))
+
+	// Output: {"quarter":"2024-Q4"}
+}
+
+func TestParseQuarter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input     string
+		want      string
+		wantStart string
+		wantEnd   string
+		wantErr   error
+	}{
+		{input: "2024-Q1", want: "2024-Q1", wantStart: "2024-01-01", wantEnd: "2024-03-31"},
+		{input: "2024Q2", want: "2024-Q2", wantStart: "2024-04-01", wantEnd: "2024-06-30"},
+		{input: "Q3 2024", want: "2024-Q3", wantStart: "2024-07-01", wantEnd: "2024-09-30"},
+		{input: " q4 2024 ", want: "2024-Q4", wantStart: "2024-10-01", wantEnd: "2024-12-31"},
+		{input: "2024-Q5", wantErr: dte.ErrFiscalPeriodRange},
+		{input: "2024-Q0", wantErr: dte.ErrFiscalPeriodRange},
+		{input: "24-Q1", wantErr: dte.ErrQuarterParse},
+		{input: "2024-H1", wantErr: dte.ErrQuarterParse},
+		{input: "Q1-2024", wantErr: dte.ErrQuarterParse},
+		{input: "", wantErr: dte.ErrQuarterParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseQuarter(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseQuarter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.String() != tt.want || got.Start().String() != tt.wantStart || got.End().String() != tt.wantEnd {
+				t.Errorf("ParseQuarter() = %v from %v to %v, want %v", got, got.Start(), got.End(), tt.want)
+			}
+		})
+	}
+}
+
+func TestQuarterNavigation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		start    string
+		quarters int
+		want     string
+	}{
+		{start: "2024-Q4", quarters: 1, want: "2025-Q1"},
+		{start: "2024-Q1", quarters: -1, want: "2023-Q4"},
+		{start: "2024-Q2", quarters: -9, want: "2022-Q1"},
+		{start: "2024-Q2", quarters: 0, want: "2024-Q2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.start, tt.quarters), func(t *testing.T) {
+			t.Parallel()
+
+			start, err := dte.ParseQuarter(tt.start)
+			if err != nil {
+				t.Fatalf("ParseQuarter() error = %v", err)
+			}
+
+			got := start.AddQuarters(tt.quarters)
+			if got.String() != tt.want || got.Diff(start) != tt.quarters {
+				t.Errorf("AddQuarters() = %v with Diff() %v, want %v", got, got.Diff(start), tt.want)
+			}
+
+			if tt.quarters < 0 && (!got.Before(start) || !start.After(got)) {
+				t.Errorf("Compare() = %v", got.Compare(start))
+			}
+		})
+	}
+}
+
+func TestQuarterFiscalJSON(t *testing.T) {
+	t.Parallel()
+
+	japan, err := dte.NewFiscalCalendar(time.April, dte.FiscalYearNamedByStart)
+	if err != nil {
+		t.Fatalf("NewFiscalCalendar() error = %v", err)
+	}
+
+	quarter, err := japan.Quarter(2024, 4)
+	if err != nil {
+		t.Fatalf("Quarter() error = %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`"2024-Q4"`), &quarter)
+	if err != nil || quarter.Start().String() != "2025-01-01" || quarter.Calendar() != japan {
+		t.Errorf("Unmarshal() = %v starting %v, %v, want the fiscal calendar kept", quarter, quarter.Start(), err)
+	}
+
+	var zero dte.Quarter
+
+	marshaled, err := json.Marshal(zero)
+	if err != nil || string(marshaled) != "null" || !zero.IsZero() || zero.Contains(dte.Date{}) {
+		t.Errorf("Marshal() = %s, %v, want null", marshaled, err)
+	}
+
+	_, err = dte.NewQuarter(2024, 5)
+	if !errors.Is(err, dte.ErrFiscalPeriodRange) {
+		t.Errorf("NewQuarter() error = %v, wantErr %v", err, dte.ErrFiscalPeriodRange)
+	}
+}
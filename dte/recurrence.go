@@ -0,0 +1,95 @@
+package dte
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Recurrence is the RFC 5545 recurrence set of an event: its DTSTART, the RRULE rules expanded from it and the RDATE
+// dates added to it, less the EXDATE dates excluded from it. An all-day event starts at midnight of its date and its
+// occurrences are read as dates with Dates.
+type Recurrence struct {
+	// Start is the DTSTART of the event, whose time zone the rules are expanded in.
+	Start time.Time
+	// Rules are the RRULE rules of the event.
+	Rules []RRule
+	// RDates are extra occurrences of the event.
+	RDates []time.Time
+	// ExDates are the occurrences that are left out, matched by their instant.
+	ExDates []time.Time
+}
+
+// Occurrences returns the start, the occurrences of every rule and the added dates in order, each instant once, less
+// the excluded dates. It ends when every rule has ended.
+func (r Recurrence) Occurrences() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		sources := []iter.Seq[time.Time]{slices.Values(slices.SortedFunc(
+			slices.Values(append([]time.Time{r.Start}, r.RDates...)), time.Time.Compare))}
+
+		for _, rule := range r.Rules {
+			sources = append(sources, rule.Occurrences(r.Start))
+		}
+
+		nexts := make([]func() (time.Time, bool), 0, len(sources))
+		heads := make([]time.Time, 0, len(sources))
+		live := make([]bool, 0, len(sources))
+
+		for _, source := range sources {
+			next, stop := iter.Pull(source)
+			defer stop()
+
+			head, ok := next()
+			nexts, heads, live = append(nexts, next), append(heads, head), append(live, ok)
+		}
+
+		var last time.Time
+
+		for emitted := false; ; {
+			earliest := -1
+
+			for i := range heads {
+				if live[i] && (earliest < 0 || heads[i].Before(heads[earliest])) {
+					earliest = i
+				}
+			}
+
+			if earliest < 0 {
+				return
+			}
+
+			occurrence := heads[earliest]
+			heads[earliest], live[earliest] = nexts[earliest]()
+
+			if emitted && occurrence.Equal(last) || slices.ContainsFunc(r.ExDates, occurrence.Equal) {
+				continue
+			}
+
+			last, emitted = occurrence, true
+
+			if !yield(occurrence) {
+				return
+			}
+		}
+	}
+}
+
+// Dates returns the dates of the occurrences in the time zone of the start, each date once, for all-day events.
+func (r Recurrence) Dates() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		var last time.Time
+
+		for occurrence := range r.Occurrences() {
+			day := civilDate(occurrence)
+			if day.Equal(last) {
+				continue
+			}
+
+			last = day
+
+			if !yield(Date{day}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package dte_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleRecurrence() {
+	rule, err := dte.ParseRRule("FREQ=WEEKLY;BYDAY=MO;COUNT=4")
+	if err != nil {
+		return
+	}
+
+	standup := dte.Recurrence{
+		Start:   time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Rules:   []dte.RRule{rule},
+		RDates:  []time.Time{time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)},
+		ExDates: []time.Time{time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for day := range standup.Dates() {
+		fmt.Println(day)
+	}
+
+	// Output:
+	// 2024-01-01
+	// 2024-01-08
+	// 2024-01-10
+	// 2024-01-22
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	t.Parallel()
+
+	monthly, err := dte.ParseRRule("FREQ=MONTHLY;BYMONTHDAY=1;COUNT=3")
+	if err != nil {
+		t.Fatalf("ParseRRule() error = %v", err)
+	}
+
+	lastFriday, err := dte.ParseRRule("FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20240301")
+	if err != nil {
+		t.Fatalf("ParseRRule() error = %v", err)
+	}
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		recurrence dte.Recurrence
+		want       []string
+	}{
+		{
+			name:       "start only",
+			recurrence: dte.Recurrence{Start: start},
+			want:       []string{"2024-01-01"},
+		},
+		{
+			name:       "rules are merged",
+			recurrence: dte.Recurrence{Start: start, Rules: []dte.RRule{monthly, lastFriday}},
+			want:       []string{"2024-01-01", "2024-01-26", "2024-02-01", "2024-02-23", "2024-03-01"},
+		},
+		{
+			name: "added dates are sorted and not repeated",
+			recurrence: dte.Recurrence{
+				Start:  start,
+				Rules:  []dte.RRule{monthly},
+				RDates: []time.Time{start.AddDate(0, 2, 0), start.AddDate(0, 0, 3), start.AddDate(0, 0, 2)},
+			},
+			want: []string{"2024-01-01", "2024-01-03", "2024-01-04", "2024-02-01", "2024-03-01"},
+		},
+		{
+			name: "excluded dates",
+			recurrence: dte.Recurrence{
+				Start:   start,
+				Rules:   []dte.RRule{monthly},
+				ExDates: []time.Time{start, start.AddDate(0, 1, 0)},
+			},
+			want: []string{"2024-03-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for occurrence := range tt.recurrence.Occurrences() {
+				got = append(got, formatOccurrence(occurrence))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceDates(t *testing.T) {
+	t.Parallel()
+
+	twiceDaily, err := dte.ParseRRule("FREQ=DAILY;BYHOUR=9,17;COUNT=4")
+	if err != nil {
+		t.Fatalf("ParseRRule() error = %v", err)
+	}
+
+	recurrence := dte.Recurrence{
+		Start: time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC),
+		Rules: []dte.RRule{twiceDaily},
+	}
+
+	var got []string
+	for day := range recurrence.Dates() {
+		got = append(got, day.String())
+	}
+
+	if want := []string{"2024-01-01", "2024-01-02"}; !slices.Equal(got, want) {
+		t.Errorf("Dates() = %v, want %v", got, want)
+	}
+}
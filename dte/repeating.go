@@ -0,0 +1,335 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrRepeatingIntervalParse = errors.New("repeating interval does not follow the ISO 8601 Rn/start/end format")
+
+// unboundedRepetitions is the number of repetitions of an R/ or R-1/ repeating interval, which never ends.
+const unboundedRepetitions = -1
+
+// RepeatingInterval is an ISO 8601 repeating interval, such as R5/2024-01-01/P1W for five weekly intervals starting on
+// 2024-01-01. It is given by its start and a duration, a duration and its end, or the start and end of its first
+// interval, and the start and end may be dates or date-times. Its zero value is no repeating interval at all.
+type RepeatingInterval struct { //nolint:recvcheck
+	repetitions int
+	start       time.Time
+	end         time.Time
+	hasStart    bool
+	hasEnd      bool
+	dateOnly    bool
+	period      Period
+	duration    time.Duration
+}
+
+// ParseRepeatingInterval parses a repeating interval in the Rn/start/duration, Rn/duration/end or Rn/start/end form.
+// The number of repetitions n may be left out, as in R/2024-01-01/P1D, or be -1 for an interval that repeats forever.
+// The start and end are ISO 8601 dates or date-times and the duration may have date and time components, such as
+// P1DT12H, but must be positive.
+func ParseRepeatingInterval(s string) (RepeatingInterval, error) {
+	parts := strings.Split(s, "/")
+
+	countText, ok := strings.CutPrefix(parts[0], "R")
+	if !ok || len(parts) != 3 { //nolint:mnd
+		return RepeatingInterval{}, fmt.Errorf("%w: %q", ErrRepeatingIntervalParse, s)
+	}
+
+	interval := RepeatingInterval{repetitions: unboundedRepetitions}
+
+	if countText != "" {
+		repetitions, err := strconv.Atoi(countText)
+		if err != nil || repetitions < unboundedRepetitions || strings.HasPrefix(countText, "+") {
+			return RepeatingInterval{}, fmt.Errorf("%w: %q is not a number of repetitions", ErrRepeatingIntervalParse, s)
+		}
+
+		interval.repetitions = repetitions
+	}
+
+	err := interval.parseBounds(parts[1], parts[2])
+	if err != nil {
+		return RepeatingInterval{}, fmt.Errorf("ParseRepeatingInterval %q: %w", s, err)
+	}
+
+	return interval, nil
+}
+
+// IsZero reports whether r is the zero RepeatingInterval.
+func (r RepeatingInterval) IsZero() bool {
+	return !r.hasStart && !r.hasEnd
+}
+
+// Repetitions returns the number of intervals and true, or false when r repeats forever.
+func (r RepeatingInterval) Repetitions() (int, bool) {
+	if r.repetitions == unboundedRepetitions {
+		return 0, false
+	}
+
+	return r.repetitions, true
+}
+
+// Start returns the start of the first interval and true, or false when r is given by a duration and its end.
+func (r RepeatingInterval) Start() (time.Time, bool) {
+	return r.start, r.hasStart
+}
+
+// End returns the end of the last interval, or of the first one in the start and end form, and true, or false when r
+// is given by its start and a duration.
+func (r RepeatingInterval) End() (time.Time, bool) {
+	return r.end, r.hasEnd
+}
+
+// Duration returns the length of each interval as the period of its date components and the duration of its time
+// components. In the start and end form the period is zero and the duration is the time between the two.
+func (r RepeatingInterval) Duration() (Period, time.Duration) {
+	return r.period, r.duration
+}
+
+// Occurrences returns the start of every interval. Adding the date components of the duration to a day past the end
+// of a month clamps it to the last day, and every start is counted from the first one, so R/2024-01-31/P1M is on the
+// last day of each month. A bounded repeating interval given by its end is yielded oldest first, but an unbounded one
+// can only be yielded backwards from its end. An unbounded sequence stops at the years 1 and 9999.
+func (r RepeatingInterval) Occurrences() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if r.IsZero() {
+			return
+		}
+
+		if r.hasStart {
+			for n := 0; r.repetitions == unboundedRepetitions || n < r.repetitions; n++ {
+				occurrence, ok := r.step(r.start, n)
+				if !ok || occurrence.Year() > maxYear || !yield(occurrence) {
+					return
+				}
+			}
+
+			return
+		}
+
+		if r.repetitions != unboundedRepetitions {
+			for n := r.repetitions; n > 0; n-- {
+				occurrence, ok := r.step(r.end, -n)
+				if !ok || !yield(occurrence) {
+					return
+				}
+			}
+
+			return
+		}
+
+		for n := 1; ; n++ {
+			occurrence, ok := r.step(r.end, -n)
+			if !ok || occurrence.Year() < minYear || !yield(occurrence) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the repeating interval in the form it was given in, such as R5/2024-01-01/P1W, leaving out the
+// number of repetitions of an unbounded one, or an empty string for the zero RepeatingInterval.
+func (r RepeatingInterval) String() string {
+	if r.IsZero() {
+		return ""
+	}
+
+	parts := []string{"R"}
+	if r.repetitions != unboundedRepetitions {
+		parts[0] += strconv.Itoa(r.repetitions)
+	}
+
+	switch {
+	case r.hasStart && r.hasEnd:
+		parts = append(parts, r.formatBound(r.start), r.formatBound(r.end))
+	case r.hasStart:
+		parts = append(parts, r.formatBound(r.start), formatRepeatDuration(r.period, r.duration))
+	default:
+		parts = append(parts, formatRepeatDuration(r.period, r.duration), r.formatBound(r.end))
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The repeating interval is a quoted string in the form it was given in, or null for the zero RepeatingInterval.
+func (r RepeatingInterval) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + r.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The repeating interval must be a quoted ISO 8601 repeating interval, null leaves it unchanged.
+func (r *RepeatingInterval) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("RepeatingInterval.UnmarshalJSON: %w: input is not a JSON string", ErrRepeatingIntervalParse)
+	}
+
+	return r.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (r RepeatingInterval) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (r *RepeatingInterval) UnmarshalText(data []byte) error {
+	parsed, err := ParseRepeatingInterval(string(data))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// parseBounds parses the start and end parts of a repeating interval, one of which may be a duration.
+func (r *RepeatingInterval) parseBounds(first string, second string) error {
+	var err error
+
+	switch {
+	case strings.HasPrefix(first, "P") && strings.HasPrefix(second, "P"):
+		return fmt.Errorf("%w: both parts are durations", ErrRepeatingIntervalParse)
+	case strings.HasPrefix(second, "P"):
+		r.start, r.dateOnly, err = parseRepeatBound(first)
+		if err != nil {
+			return err
+		}
+
+		r.hasStart = true
+		r.period, r.duration, err = parseRepeatDuration(second)
+	case strings.HasPrefix(first, "P"):
+		r.end, r.dateOnly, err = parseRepeatBound(second)
+		if err != nil {
+			return err
+		}
+
+		r.hasEnd = true
+		r.period, r.duration, err = parseRepeatDuration(first)
+	default:
+		return r.parseStartEnd(first, second)
+	}
+
+	return err
+}
+
+// parseStartEnd parses the start and end of the first interval of a repeating interval.
+func (r *RepeatingInterval) parseStartEnd(first string, second string) error {
+	start, startDateOnly, err := parseRepeatBound(first)
+	if err != nil {
+		return err
+	}
+
+	end, endDateOnly, err := parseRepeatBound(second)
+	if err != nil {
+		return err
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("%w: the end is not after the start", ErrRepeatingIntervalParse)
+	}
+
+	r.start, r.end, r.hasStart, r.hasEnd = start, end, true, true
+	r.duration, r.dateOnly = end.Sub(start), startDateOnly && endDateOnly
+
+	return nil
+}
+
+// step returns the start of the interval n intervals after from, or before it for a negative n, and false when it
+// does not fit a time.Time.
+func (r RepeatingInterval) step(from time.Time, n int) (time.Time, bool) {
+	if r.duration != 0 && (n > math.MaxInt64/int(r.duration) || n < -math.MaxInt64/int(r.duration)) {
+		return time.Time{}, false
+	}
+
+	period := Period{
+		Years:  r.period.Years * n,
+		Months: r.period.Months * n,
+		Weeks:  r.period.Weeks * n,
+		Days:   r.period.Days * n,
+	}
+
+	return period.AddTo(Date{from}, MonthEndClamp).Add(time.Duration(n) * r.duration), true
+}
+
+// formatBound returns the start or end as a date when r was given with dates, and as an RFC 3339 date-time otherwise.
+func (r RepeatingInterval) formatBound(t time.Time) string {
+	if r.dateOnly {
+		return t.Format(DateOnly)
+	}
+
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseRepeatBound parses an ISO 8601 date or date-time and reports whether it was a date only.
+func parseRepeatBound(s string) (time.Time, bool, error) {
+	parsed, err := ParseISODate(s)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%w: %w", ErrRepeatingIntervalParse, err)
+	}
+
+	return parsed.Time, !strings.Contains(s, "T"), nil
+}
+
+// parseRepeatDuration parses a positive ISO 8601 duration into the period of its date components and the duration of
+// its time components.
+func parseRepeatDuration(s string) (Period, time.Duration, error) {
+	if strings.ContainsAny(s, "+-") {
+		return Period{}, 0, fmt.Errorf("%w: duration %q is not positive", ErrRepeatingIntervalParse, s)
+	}
+
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+
+	var (
+		period   Period
+		duration time.Duration
+		err      error
+	)
+
+	if datePart != "P" || !hasTime {
+		period, err = ParsePeriod(datePart)
+		if err != nil {
+			return Period{}, 0, fmt.Errorf("%w: %w", ErrRepeatingIntervalParse, err)
+		}
+	}
+
+	if hasTime {
+		duration, err = parseISODuration("PT" + timePart)
+		if err != nil {
+			return Period{}, 0, fmt.Errorf("%w: %w", ErrRepeatingIntervalParse, err)
+		}
+	}
+
+	if period.IsZero() && duration == 0 {
+		return Period{}, 0, fmt.Errorf("%w: duration %q is not positive", ErrRepeatingIntervalParse, s)
+	}
+
+	return period, duration, nil
+}
+
+// formatRepeatDuration returns the ISO 8601 duration of the period and the duration of the time components.
+func formatRepeatDuration(period Period, duration time.Duration) string {
+	if duration == 0 {
+		return period.String()
+	}
+
+	if period.IsZero() {
+		return formatISODuration(duration)
+	}
+
+	return period.String() + strings.TrimPrefix(formatISODuration(duration), "P")
+}
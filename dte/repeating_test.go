@@ -0,0 +1,197 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseRepeatingInterval() {
+	deliveries, err := dte.ParseRepeatingInterval("R3/2024-01-31/P1M")
+	if err != nil {
+		return
+	}
+
+	for occurrence := range deliveries.Occurrences() {
+		fmt.Println(occurrence.Format(dte.DateOnly))
+	}
+
+	fmt.Println(deliveries)
+
+	// Output:
+	// 2024-01-31
+	// 2024-02-29
+	// 2024-03-31
+	// R3/2024-01-31/P1M
+}
+
+func ExampleRepeatingInterval_json() {
+	type Report struct {
+		Schedule dte.RepeatingInterval `json:"schedule"`
+	}
+
+	var report Report
+
+	err := json.Unmarshal([]byte(`{"schedule":"R-1/2024-01-01T09:00:00Z/PT12H"}`), &report)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(report)
+	if err != nil {
+		return
+	}
+
+	repetitions, bounded := report.Schedule.Repetitions()
+
+	fmt.Println(string(marshaled), repetitions, bounded)
+
+	// Output: {"schedule":"R/2024-01-01T09:00:00Z/PT12H"} 0 false
+}
+
+func TestParseRepeatingInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input      string
+		wantString string
+		want       []string
+	}{
+		{
+			input:      "R5/2024-01-01/P1W",
+			wantString: "R5/2024-01-01/P1W",
+			want:       []string{"2024-01-01", "2024-01-08", "2024-01-15", "2024-01-22", "2024-01-29"},
+		},
+		{
+			input:      "R3/P1M/2024-04-30",
+			wantString: "R3/P1M/2024-04-30",
+			want:       []string{"2024-01-30", "2024-02-29", "2024-03-30"},
+		},
+		{
+			input:      "R2/2024-01-01T09:00:00Z/2024-01-01T10:30:00Z",
+			wantString: "R2/2024-01-01T09:00:00Z/2024-01-01T10:30:00Z",
+			want:       []string{"2024-01-01T09:00:00Z", "2024-01-01T10:30:00Z"},
+		},
+		{
+			input:      "R2/2024-01-01/2024-01-08",
+			wantString: "R2/2024-01-01/2024-01-08",
+			want:       []string{"2024-01-01", "2024-01-08"},
+		},
+		{
+			input:      "R2/20240301/P1DT12H",
+			wantString: "R2/2024-03-01/P1DT12H",
+			want:       []string{"2024-03-01", "2024-03-02T12:00:00Z"},
+		},
+		{
+			input:      "R2/2024-01-01T12:00+02:00/PT12H",
+			wantString: "R2/2024-01-01T12:00:00+02:00/PT12H",
+			want:       []string{"2024-01-01T12:00:00+02:00", "2024-01-02T00:00:00+02:00"},
+		},
+		{
+			input:      "R/2024-01-31/P1M",
+			wantString: "R/2024-01-31/P1M",
+			want:       []string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30"},
+		},
+		{
+			input:      "R/P1D/2024-01-03",
+			wantString: "R/P1D/2024-01-03",
+			want:       []string{"2024-01-02", "2024-01-01", "2023-12-31"},
+		},
+		{input: "R0/2024-01-01/P1D", wantString: "R0/2024-01-01/P1D", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseRepeatingInterval(tt.input)
+			if err != nil {
+				t.Fatalf("ParseRepeatingInterval() error = %v", err)
+			}
+
+			if got.String() != tt.wantString {
+				t.Errorf("String() = %v, want %v", got, tt.wantString)
+			}
+
+			var occurrences []string
+
+			for occurrence := range got.Occurrences() {
+				if len(occurrences) == len(tt.want) {
+					break
+				}
+
+				occurrences = append(occurrences, formatOccurrence(occurrence))
+			}
+
+			if !slices.Equal(occurrences, tt.want) {
+				t.Errorf("Occurrences() = %v, want %v", occurrences, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRepeatingIntervalErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"R5/2024-01-01",
+		"5/2024-01-01/P1D",
+		"R+5/2024-01-01/P1D",
+		"R-2/2024-01-01/P1D",
+		"Rx/2024-01-01/P1D",
+		"R5/P1D/P1D",
+		"R5/2024-01-01/P0D",
+		"R5/2024-01-01/-P1D",
+		"R5/2024-01-01/P1D-1W",
+		"R5/2024-01-01/PT",
+		"R5/2024-01-02/2024-01-01",
+		"R5/2024-13-01/P1D",
+		"R5/2024-01-01/P1D/P1D",
+		"",
+	} {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dte.ParseRepeatingInterval(input)
+			if !errors.Is(err, dte.ErrRepeatingIntervalParse) {
+				t.Errorf("ParseRepeatingInterval() error = %v, want %v", err, dte.ErrRepeatingIntervalParse)
+			}
+		})
+	}
+}
+
+func TestRepeatingIntervalJSON(t *testing.T) {
+	t.Parallel()
+
+	var interval dte.RepeatingInterval
+
+	marshaled, err := json.Marshal(interval)
+	if err != nil || string(marshaled) != "null" {
+		t.Fatalf("Marshal() = %s, %v, want null", marshaled, err)
+	}
+
+	for _, input := range []string{`5`, `"R5/P1D"`} {
+		err := json.Unmarshal([]byte(input), &interval)
+		if !errors.Is(err, dte.ErrRepeatingIntervalParse) {
+			t.Errorf("Unmarshal(%s) error = %v, want %v", input, err, dte.ErrRepeatingIntervalParse)
+		}
+	}
+
+	if occurrences := slices.Collect(interval.Occurrences()); len(occurrences) != 0 {
+		t.Errorf("Occurrences() of the zero RepeatingInterval = %v, want none", occurrences)
+	}
+}
+
+// formatOccurrence formats midnight UTC as a date and any other instant as an RFC 3339 date-time.
+func formatOccurrence(t time.Time) string {
+	if t.Location() == time.UTC && t.Equal(t.Truncate(24*time.Hour)) {
+		return t.Format(dte.DateOnly)
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,869 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrRRuleParse = errors.New("recurrence rule does not follow the RFC 5545 RRULE format")
+
+// Frequency is the FREQ of a recurrence rule, the length of the periods its occurrences are found in.
+type Frequency int
+
+const (
+	// FrequencySecondly repeats every second.
+	FrequencySecondly Frequency = iota + 1
+	// FrequencyMinutely repeats every minute.
+	FrequencyMinutely
+	// FrequencyHourly repeats every hour.
+	FrequencyHourly
+	// FrequencyDaily repeats every day.
+	FrequencyDaily
+	// FrequencyWeekly repeats every week.
+	FrequencyWeekly
+	// FrequencyMonthly repeats every month.
+	FrequencyMonthly
+	// FrequencyYearly repeats every year.
+	FrequencyYearly
+)
+
+// RRuleSkip is the RFC 7529 SKIP of a recurrence rule, what happens to an occurrence on a day its month does not
+// have, such as February 29th in a common year.
+type RRuleSkip int
+
+const (
+	// RRuleSkipOmit leaves the occurrence out, as RFC 5545 does.
+	RRuleSkipOmit RRuleSkip = iota
+	// RRuleSkipBackward moves the occurrence to the last day of the month.
+	RRuleSkipBackward
+	// RRuleSkipForward moves the occurrence to the first day of the next month.
+	RRuleSkipForward
+)
+
+// rruleUntilForm is the form of the UNTIL of a recurrence rule.
+type rruleUntilForm int
+
+const (
+	rruleUntilNone rruleUntilForm = iota
+	rruleUntilDate
+	rruleUntilUTC
+	rruleUntilFloating
+)
+
+const (
+	rruleDateLayout     = "20060102"
+	rruleDateTimeLayout = "20060102T150405"
+	maxMonthDay         = 31
+	maxYearDay          = 366
+	maxWeekNo           = 53
+	isoThursdayOffset   = 3
+)
+
+var (
+	frequencyNames = map[Frequency]string{ //nolint:gochecknoglobals
+		FrequencySecondly: "SECONDLY",
+		FrequencyMinutely: "MINUTELY",
+		FrequencyHourly:   "HOURLY",
+		FrequencyDaily:    "DAILY",
+		FrequencyWeekly:   "WEEKLY",
+		FrequencyMonthly:  "MONTHLY",
+		FrequencyYearly:   "YEARLY",
+	}
+	weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"} //nolint:gochecknoglobals
+	skipNames    = []string{"OMIT", "BACKWARD", "FORWARD"}            //nolint:gochecknoglobals
+	// rruleOrder is the order String writes the parts of a rule in.
+	rruleOrder = []string{ //nolint:gochecknoglobals
+		"FREQ", "UNTIL", "COUNT", "INTERVAL", "BYSECOND", "BYMINUTE", "BYHOUR", "BYDAY", "BYMONTHDAY", "BYYEARDAY",
+		"BYWEEKNO", "BYMONTH", "BYSETPOS", "WKST", "RSCALE", "SKIP",
+	}
+)
+
+// RRule is an RFC 5545 recurrence rule such as FREQ=MONTHLY;BYDAY=2TU for the second Tuesday of every month. It is
+// expanded from the DTSTART of an event by Occurrences, or together with added and excluded dates by a Recurrence.
+// The RFC 7529 RSCALE=GREGORIAN and SKIP parts are supported to move occurrences on missing days instead of leaving
+// them out. Its zero value is no rule at all.
+type RRule struct { //nolint:recvcheck
+	frequency  Frequency
+	interval   int
+	count      int
+	until      time.Time
+	untilForm  rruleUntilForm
+	weekStart  time.Weekday
+	hasWKST    bool
+	rscale     bool
+	skip       RRuleSkip
+	bySecond   []int
+	byMinute   []int
+	byHour     []int
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byYearDay  []int
+	byWeekNo   []int
+	byMonth    []int
+	bySetPos   []int
+}
+
+// rruleWeekday is a BYDAY weekday, with the number of the weekday in the month or year, such as 2 for 2TU and -1
+// for -1FR, or 0 for every such weekday.
+type rruleWeekday struct {
+	n       int
+	weekday time.Weekday
+}
+
+// ParseRRule parses a recurrence rule, with or without the RRULE: prefix, such as FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29.
+// Parts may come in any order but not twice, and the rule is checked against the RFC 5545 restrictions, such as
+// COUNT and UNTIL not being combined and numbered BYDAY weekdays only appearing in monthly and yearly rules.
+func ParseRRule(s string) (RRule, error) {
+	rule := RRule{weekStart: time.Monday}
+
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(strings.TrimPrefix(s, "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+
+		if !ok || seen[name] {
+			return RRule{}, fmt.Errorf("%w: %q has a malformed or repeated part %q", ErrRRuleParse, s, part)
+		}
+
+		seen[name] = true
+
+		err := rule.parsePart(name, strings.ToUpper(value))
+		if err != nil {
+			return RRule{}, fmt.Errorf("ParseRRule %q: %s: %w", s, name, err)
+		}
+	}
+
+	err := rule.validate()
+	if err != nil {
+		return RRule{}, fmt.Errorf("ParseRRule %q: %w", s, err)
+	}
+
+	return rule, nil
+}
+
+// IsZero reports whether r is the zero RRule.
+func (r RRule) IsZero() bool {
+	return r.frequency == 0
+}
+
+// Frequency returns the FREQ of the rule.
+func (r RRule) Frequency() Frequency {
+	return r.frequency
+}
+
+// Interval returns the INTERVAL of the rule, 1 when it has none.
+func (r RRule) Interval() int {
+	return max(r.interval, 1)
+}
+
+// Count returns the COUNT of the rule and true, or false when it has none.
+func (r RRule) Count() (int, bool) {
+	return r.count, r.count > 0
+}
+
+// Until returns the UNTIL of the rule and true, or false when it has none. A date is midnight UTC and a floating
+// date-time is its wall clock in UTC, both read in the time zone of the start the rule is expanded from.
+func (r RRule) Until() (time.Time, bool) {
+	return r.until, r.untilForm != rruleUntilNone
+}
+
+// Occurrences returns the occurrences of the rule from the start, which always counts as the first one, in the time
+// zone of the start. Wall clock times that a daylight saving time change skips are moved forward by the length of the
+// gap and those it repeats fall on their first instance, while hourly and shorter frequencies step in elapsed time.
+// Without a COUNT or UNTIL the sequence stops at the year 9999.
+func (r RRule) Occurrences(start time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if r.IsZero() || r.pastUntil(start) || !yield(start) {
+			return
+		}
+
+		expansion := r.expansion(start)
+		emitted := 1
+
+		for period := 0; ; {
+			candidates, next, ok := expansion.period(period)
+			if !ok {
+				return
+			}
+
+			period = next
+
+			for _, candidate := range candidates {
+				if !candidate.After(start) {
+					continue
+				}
+
+				if r.pastUntil(candidate) || r.count > 0 && emitted >= r.count {
+					return
+				}
+
+				emitted++
+
+				if !yield(candidate) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// String returns the rule in the RFC 5545 form without the RRULE: prefix, with its parts in a fixed order, or an
+// empty string for the zero RRule.
+func (r RRule) String() string {
+	if r.IsZero() {
+		return ""
+	}
+
+	parts := make([]string, 0, len(rruleOrder))
+
+	for _, name := range rruleOrder {
+		if value := r.partString(name); value != "" {
+			parts = append(parts, name+"="+value)
+		}
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The rule is a quoted string in the RFC 5545 form, or null for the zero RRule.
+func (r RRule) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + r.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The rule must be a quoted string in the RFC 5545 form, null leaves it unchanged.
+func (r *RRule) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("RRule.UnmarshalJSON: %w: input is not a JSON string", ErrRRuleParse)
+	}
+
+	return r.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (r RRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (r *RRule) UnmarshalText(data []byte) error {
+	parsed, err := ParseRRule(string(data))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// String returns the FREQ name of the frequency, such as MONTHLY.
+func (f Frequency) String() string {
+	return frequencyNames[f]
+}
+
+//nolint:cyclop
+func (r *RRule) parsePart(name string, value string) error {
+	var err error
+
+	switch name {
+	case "FREQ":
+		for frequency, frequencyName := range frequencyNames {
+			if frequencyName == value {
+				r.frequency = frequency
+			}
+		}
+
+		if r.frequency == 0 {
+			err = fmt.Errorf("%w: unknown frequency %q", ErrRRuleParse, value)
+		}
+	case "UNTIL":
+		err = r.parseUntil(value)
+	case "COUNT":
+		r.count, err = parseRRuleNumber(value)
+	case "INTERVAL":
+		r.interval, err = parseRRuleNumber(value)
+	case "BYSECOND":
+		r.bySecond, err = parseRRuleList(value, 0, isoMaxSecond, false)
+	case "BYMINUTE":
+		r.byMinute, err = parseRRuleList(value, 0, isoMaxMinute, false)
+	case "BYHOUR":
+		r.byHour, err = parseRRuleList(value, 0, isoMaxHour, false)
+	case "BYDAY":
+		r.byDay, err = parseRRuleWeekdays(value)
+	case "BYMONTHDAY":
+		r.byMonthDay, err = parseRRuleList(value, 1, maxMonthDay, true)
+	case "BYYEARDAY":
+		r.byYearDay, err = parseRRuleList(value, 1, maxYearDay, true)
+	case "BYWEEKNO":
+		r.byWeekNo, err = parseRRuleList(value, 1, maxWeekNo, true)
+	case "BYMONTH":
+		r.byMonth, err = parseRRuleList(value, 1, monthsPerYear, false)
+	case "BYSETPOS":
+		r.bySetPos, err = parseRRuleList(value, 1, maxYearDay, true)
+	case "WKST":
+		r.weekStart, r.hasWKST = time.Weekday(slices.Index(weekdayCodes, value)), true
+		if r.weekStart < 0 {
+			err = fmt.Errorf("%w: unknown weekday %q", ErrRRuleParse, value)
+		}
+	case "RSCALE":
+		r.rscale = true
+		if value != "GREGORIAN" {
+			err = fmt.Errorf("%w: only the GREGORIAN scale is supported, not %q", ErrRRuleParse, value)
+		}
+	case "SKIP":
+		r.skip = RRuleSkip(slices.Index(skipNames, value))
+		if r.skip < 0 {
+			err = fmt.Errorf("%w: unknown skip %q", ErrRRuleParse, value)
+		}
+	default:
+		err = fmt.Errorf("%w: unknown part", ErrRRuleParse)
+	}
+
+	return err
+}
+
+func (r *RRule) parseUntil(value string) error {
+	var err error
+
+	switch {
+	case len(value) == len(rruleDateLayout):
+		r.until, err = time.Parse(rruleDateLayout, value)
+		r.untilForm = rruleUntilDate
+	case strings.HasSuffix(value, "Z"):
+		r.until, err = time.Parse(rruleDateTimeLayout+"Z", value)
+		r.untilForm = rruleUntilUTC
+	default:
+		r.until, err = time.Parse(rruleDateTimeLayout, value)
+		r.untilForm = rruleUntilFloating
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %q is not a date or date-time: %w", ErrRRuleParse, value, err)
+	}
+
+	return nil
+}
+
+// validate checks the rule parts that depend on each other.
+func (r *RRule) validate() error {
+	numbered := slices.ContainsFunc(r.byDay, func(day rruleWeekday) bool { return day.n != 0 })
+	hasBy := len(r.bySecond)+len(r.byMinute)+len(r.byHour)+len(r.byDay)+len(r.byMonthDay)+len(r.byYearDay)+
+		len(r.byWeekNo)+len(r.byMonth) > 0
+
+	var problem string
+
+	switch {
+	case r.frequency == 0:
+		problem = "FREQ is missing"
+	case r.count > 0 && r.untilForm != rruleUntilNone:
+		problem = "COUNT and UNTIL are both given"
+	case numbered && r.frequency != FrequencyMonthly && r.frequency != FrequencyYearly:
+		problem = "numbered BYDAY weekdays need a MONTHLY or YEARLY frequency"
+	case numbered && r.frequency == FrequencyYearly && len(r.byWeekNo) > 0:
+		problem = "numbered BYDAY weekdays can not be combined with BYWEEKNO"
+	case len(r.byMonthDay) > 0 && r.frequency == FrequencyWeekly:
+		problem = "BYMONTHDAY can not be used with a WEEKLY frequency"
+	case len(r.byYearDay) > 0 && r.frequency >= FrequencyDaily && r.frequency <= FrequencyMonthly:
+		problem = "BYYEARDAY can not be used with a DAILY, WEEKLY or MONTHLY frequency"
+	case len(r.byWeekNo) > 0 && r.frequency != FrequencyYearly:
+		problem = "BYWEEKNO needs a YEARLY frequency"
+	case len(r.bySetPos) > 0 && !hasBy:
+		problem = "BYSETPOS needs another BYxxx part"
+	case r.skip != RRuleSkipOmit && !r.rscale:
+		problem = "SKIP needs RSCALE"
+	default:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrRRuleParse, problem)
+}
+
+//nolint:cyclop
+func (r RRule) partString(name string) string {
+	switch name {
+	case "FREQ":
+		return r.frequency.String()
+	case "UNTIL":
+		return r.untilString()
+	case "COUNT":
+		return formatRRuleNumber(r.count)
+	case "INTERVAL":
+		return formatRRuleNumber(r.interval)
+	case "BYSECOND":
+		return formatRRuleList(r.bySecond)
+	case "BYMINUTE":
+		return formatRRuleList(r.byMinute)
+	case "BYHOUR":
+		return formatRRuleList(r.byHour)
+	case "BYDAY":
+		days := make([]string, 0, len(r.byDay))
+		for _, day := range r.byDay {
+			days = append(days, formatRRuleNumber(day.n)+weekdayCodes[day.weekday])
+		}
+
+		return strings.Join(days, ",")
+	case "BYMONTHDAY":
+		return formatRRuleList(r.byMonthDay)
+	case "BYYEARDAY":
+		return formatRRuleList(r.byYearDay)
+	case "BYWEEKNO":
+		return formatRRuleList(r.byWeekNo)
+	case "BYMONTH":
+		return formatRRuleList(r.byMonth)
+	case "BYSETPOS":
+		return formatRRuleList(r.bySetPos)
+	case "WKST":
+		if r.hasWKST {
+			return weekdayCodes[r.weekStart]
+		}
+	case "RSCALE":
+		if r.rscale {
+			return "GREGORIAN"
+		}
+	case "SKIP":
+		if r.skip != RRuleSkipOmit {
+			return skipNames[r.skip]
+		}
+	}
+
+	return ""
+}
+
+func (r RRule) untilString() string {
+	switch r.untilForm {
+	case rruleUntilDate:
+		return r.until.Format(rruleDateLayout)
+	case rruleUntilUTC:
+		return r.until.Format(rruleDateTimeLayout) + "Z"
+	case rruleUntilFloating:
+		return r.until.Format(rruleDateTimeLayout)
+	default:
+		return ""
+	}
+}
+
+// pastUntil reports whether the occurrence is after the UNTIL of the rule.
+func (r RRule) pastUntil(occurrence time.Time) bool {
+	switch r.untilForm {
+	case rruleUntilDate:
+		return civilDate(occurrence).After(r.until)
+	case rruleUntilUTC:
+		return occurrence.After(r.until)
+	case rruleUntilFloating:
+		year, month, day := r.until.Date()
+		hour, minute, second := r.until.Clock()
+
+		return occurrence.After(time.Date(year, month, day, hour, minute, second, 0, occurrence.Location()))
+	default:
+		return false
+	}
+}
+
+// rruleExpansion is a rule prepared for expanding from a start, with the parts the start implies filled in.
+type rruleExpansion struct {
+	rule      RRule
+	start     time.Time
+	startDay  time.Time
+	hours     []int
+	minutes   []int
+	seconds   []int
+	byDay     []rruleWeekday
+	monthDays []int
+	months    []int
+}
+
+func (r RRule) expansion(start time.Time) rruleExpansion {
+	expansion := rruleExpansion{
+		rule:      r,
+		start:     start,
+		startDay:  civilDate(start),
+		hours:     sortedOr(r.byHour, start.Hour()),
+		minutes:   sortedOr(r.byMinute, start.Minute()),
+		seconds:   sortedOr(r.bySecond, start.Second()),
+		byDay:     r.byDay,
+		monthDays: r.byMonthDay,
+		months:    r.byMonth,
+	}
+
+	noDays := len(r.byDay)+len(r.byMonthDay)+len(r.byYearDay)+len(r.byWeekNo) == 0
+
+	switch {
+	case r.frequency == FrequencyYearly && noDays && len(r.byMonth) == 0:
+		expansion.months, expansion.monthDays = []int{int(start.Month())}, []int{start.Day()}
+	case r.frequency == FrequencyYearly && noDays,
+		r.frequency == FrequencyMonthly && len(r.byDay)+len(r.byMonthDay) == 0:
+		expansion.monthDays = []int{start.Day()}
+	case r.frequency == FrequencyYearly && len(r.byDay)+len(r.byMonthDay)+len(r.byYearDay) == 0,
+		r.frequency == FrequencyWeekly && len(r.byDay) == 0:
+		expansion.byDay = []rruleWeekday{{n: 0, weekday: start.Weekday()}}
+	}
+
+	return expansion
+}
+
+// period returns the sorted occurrences in the period with the index, counted in steps of the interval from the
+// period of the start, and the index of the next period that may have any, or false once the period is past the year
+// 9999.
+func (e rruleExpansion) period(index int) ([]time.Time, int, bool) {
+	step := index * e.rule.Interval()
+
+	var first, end time.Time
+
+	switch e.rule.frequency {
+	case FrequencyYearly:
+		first = time.Date(e.startDay.Year()+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end = first.AddDate(1, 0, 0)
+	case FrequencyMonthly:
+		first = time.Date(e.startDay.Year(), e.startDay.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		end = first.AddDate(0, 1, 0)
+	case FrequencyWeekly:
+		offset := (int(e.startDay.Weekday()) - int(e.rule.weekStart) + daysPerWeek) % daysPerWeek
+		first = e.startDay.AddDate(0, 0, step*daysPerWeek-offset)
+		end = first.AddDate(0, 0, daysPerWeek)
+	case FrequencyDaily:
+		first = e.startDay.AddDate(0, 0, step)
+		end = first.AddDate(0, 0, 1)
+	default:
+		return e.clockPeriod(index)
+	}
+
+	if first.Year() > maxYear {
+		return nil, 0, false
+	}
+
+	days := e.days(first, end)
+	occurrences := make([]time.Time, 0, len(days)*len(e.hours)*len(e.minutes)*len(e.seconds))
+
+	for _, day := range days {
+		for _, hour := range e.hours {
+			for _, minute := range e.minutes {
+				for _, second := range e.seconds {
+					occurrences = append(occurrences, wallClock(day, hour, minute, second, e.start.Location()))
+				}
+			}
+		}
+	}
+
+	return e.setPositions(occurrences), index + 1, true
+}
+
+// clockPeriod returns the occurrences in the hour, minute or second step periods after the one of the start. These
+// periods are counted in elapsed time, so an hourly rule does not skip or repeat an hour at a daylight saving time
+// change.
+func (e rruleExpansion) clockPeriod(index int) ([]time.Time, int, bool) {
+	unit := time.Second
+	base := e.start.Add(-time.Duration(e.start.Nanosecond()))
+	offsets := []time.Duration{0}
+
+	switch e.rule.frequency {
+	case FrequencyHourly:
+		unit = time.Hour
+		base = base.Add(-time.Duration(e.start.Minute())*time.Minute - time.Duration(e.start.Second())*time.Second)
+		offsets = offsets[:0]
+
+		for _, minute := range e.minutes {
+			for _, second := range e.seconds {
+				offsets = append(offsets, time.Duration(minute)*time.Minute+time.Duration(second)*time.Second)
+			}
+		}
+	case FrequencyMinutely:
+		unit = time.Minute
+		base = base.Add(-time.Duration(e.start.Second()) * time.Second)
+		offsets = offsets[:0]
+
+		for _, second := range e.seconds {
+			offsets = append(offsets, time.Duration(second)*time.Second)
+		}
+	}
+
+	// Periods are counted in Unix seconds since a time.Duration only spans about 292 years.
+	length := int64(e.rule.Interval()) * int64(unit/time.Second)
+
+	periodStart := time.Unix(base.Unix()+int64(index)*length, 0).In(e.start.Location())
+	if periodStart.Year() > maxYear {
+		return nil, 0, false
+	}
+
+	if day := civilDate(periodStart); !e.dayMatches(day) {
+		// Skip to the first period of the next day rather than stepping through every period of this one.
+		nextDay := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, e.start.Location())
+
+		return nil, max(index+1, int((nextDay.Unix()-base.Unix()+length-1)/length)), true
+	}
+
+	if !e.clockMatches(periodStart) {
+		return nil, index + 1, true
+	}
+
+	occurrences := make([]time.Time, 0, len(offsets))
+	for _, offset := range offsets {
+		occurrences = append(occurrences, periodStart.Add(offset))
+	}
+
+	return e.setPositions(occurrences), index + 1, true
+}
+
+// clockMatches reports whether the hour, minute and second of a sub-daily period start match the BYHOUR, BYMINUTE
+// and BYSECOND parts that limit its frequency.
+func (e rruleExpansion) clockMatches(t time.Time) bool {
+	rule := e.rule
+
+	return (len(rule.byHour) == 0 || slices.Contains(rule.byHour, t.Hour())) &&
+		(rule.frequency == FrequencyHourly || len(rule.byMinute) == 0 || slices.Contains(rule.byMinute, t.Minute())) &&
+		(rule.frequency != FrequencySecondly || len(rule.bySecond) == 0 || slices.Contains(rule.bySecond, t.Second()))
+}
+
+// days returns the days from first up to end that match the rule, with the days moved by SKIP.
+func (e rruleExpansion) days(first time.Time, end time.Time) []time.Time {
+	var days []time.Time
+
+	for day := first; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if e.dayMatches(day) {
+			days = append(days, day)
+		}
+	}
+
+	if e.rule.skip == RRuleSkipOmit || len(e.byDay)+len(e.rule.byYearDay)+len(e.rule.byWeekNo) > 0 {
+		return days
+	}
+
+	for month := first; month.Before(end); month = month.AddDate(0, 1, 0) {
+		if len(e.months) > 0 && !slices.Contains(e.months, int(month.Month())) {
+			continue
+		}
+
+		monthDays := YearMonthOf(Date{month}).Days()
+
+		for _, day := range e.monthDays {
+			if day <= monthDays {
+				continue
+			}
+
+			moved := time.Date(month.Year(), month.Month(), monthDays, 0, 0, 0, 0, time.UTC)
+			if e.rule.skip == RRuleSkipForward {
+				moved = moved.AddDate(0, 0, 1)
+			}
+
+			if !slices.ContainsFunc(days, moved.Equal) {
+				days = append(days, moved)
+			}
+		}
+	}
+
+	slices.SortFunc(days, time.Time.Compare)
+
+	return days
+}
+
+// dayMatches reports whether the day matches the BYMONTH, BYWEEKNO, BYYEARDAY, BYMONTHDAY and BYDAY parts, with
+// numbered weekdays counted in the month or year as the frequency decides.
+func (e rruleExpansion) dayMatches(day time.Time) bool {
+	yearDays := time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	monthDays := YearMonthOf(Date{day}).Days()
+
+	switch {
+	case len(e.months) > 0 && !slices.Contains(e.months, int(day.Month())):
+		return false
+	case len(e.rule.byWeekNo) > 0 && !e.weekNoMatches(day):
+		return false
+	case len(e.rule.byYearDay) > 0 && !matchesOrdinal(e.rule.byYearDay, day.YearDay(), yearDays):
+		return false
+	case len(e.monthDays) > 0 && !matchesOrdinal(e.monthDays, day.Day(), monthDays):
+		return false
+	case len(e.byDay) == 0:
+		return true
+	}
+
+	inMonth := e.rule.frequency == FrequencyMonthly || len(e.rule.byMonth) > 0
+	position, last := day.YearDay(), yearDays
+
+	if inMonth {
+		position, last = day.Day(), monthDays
+	}
+
+	return slices.ContainsFunc(e.byDay, func(weekday rruleWeekday) bool {
+		switch {
+		case weekday.weekday != day.Weekday():
+			return false
+		case weekday.n > 0:
+			return (position-1)/daysPerWeek+1 == weekday.n
+		case weekday.n < 0:
+			return (last-position)/daysPerWeek+1 == -weekday.n
+		default:
+			return true
+		}
+	})
+}
+
+// weekNoMatches reports whether the week of the day, numbered from the week start with week 1 holding at least four
+// days of the year, is in BYWEEKNO.
+func (e rruleExpansion) weekNoMatches(day time.Time) bool {
+	weekOf := func(t time.Time) (int, int) {
+		offset := (int(t.Weekday()) - int(e.rule.weekStart) + daysPerWeek) % daysPerWeek
+		middle := t.AddDate(0, 0, isoThursdayOffset-offset)
+
+		return middle.Year(), (middle.YearDay()-1)/daysPerWeek + 1
+	}
+
+	year, week := weekOf(day)
+	_, weeks := weekOf(time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC))
+
+	return matchesOrdinal(e.rule.byWeekNo, week, weeks)
+}
+
+// setPositions sorts the occurrences of a period and keeps those at the BYSETPOS positions.
+func (e rruleExpansion) setPositions(occurrences []time.Time) []time.Time {
+	slices.SortFunc(occurrences, time.Time.Compare)
+	occurrences = slices.CompactFunc(occurrences, time.Time.Equal)
+
+	if len(e.rule.bySetPos) == 0 {
+		return occurrences
+	}
+
+	var kept []time.Time
+
+	for i, occurrence := range occurrences {
+		if matchesOrdinal(e.rule.bySetPos, i+1, len(occurrences)) {
+			kept = append(kept, occurrence)
+		}
+	}
+
+	return kept
+}
+
+// matchesOrdinal reports whether the position, counted from 1 up to last, is in the ordinals, where -1 is the last.
+func matchesOrdinal(ordinals []int, position int, last int) bool {
+	return slices.ContainsFunc(ordinals, func(ordinal int) bool {
+		return ordinal == position || ordinal < 0 && last+1+ordinal == position
+	})
+}
+
+// wallClock returns the wall clock time on the day in the location. A time that a daylight saving time change skips
+// is read with the offset before the change, moving it forward by the length of the gap, where time.Date moves it
+// back.
+func wallClock(day time.Time, hour int, minute int, second int, location *time.Location) time.Time {
+	t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, location)
+	if t.Hour() == hour && t.Minute() == minute {
+		return t
+	}
+
+	_, offsetBefore := t.Zone()
+
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0,
+		time.FixedZone("", offsetBefore)).In(location)
+}
+
+// civilDate returns midnight UTC of the wall clock date of t.
+func civilDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// sortedOr returns a sorted copy of values, or the fallback alone when there are none.
+func sortedOr(values []int, fallback int) []int {
+	if len(values) == 0 {
+		return []int{fallback}
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(values)))
+}
+
+// parseRRuleNumber parses the positive number of a COUNT or INTERVAL.
+func parseRRuleNumber(value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, fmt.Errorf("%w: %q is not a positive number", ErrRRuleParse, value)
+	}
+
+	return number, nil
+}
+
+// parseRRuleList parses a comma separated list of numbers from minimum to maximum, which may be negated when
+// signed is true.
+func parseRRuleList(value string, minimum int, maximum int, signed bool) ([]int, error) {
+	var numbers []int
+
+	for _, item := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(item)
+		magnitude := number
+
+		if signed && number < 0 {
+			magnitude = -number
+		}
+
+		if err != nil || magnitude < minimum || magnitude > maximum {
+			return nil, fmt.Errorf("%w: %q is not a number from %d to %d", ErrRRuleParse, item, minimum, maximum)
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
+
+// parseRRuleWeekdays parses a comma separated list of weekdays with optional numbers, such as MO,2TU,-1FR.
+func parseRRuleWeekdays(value string) ([]rruleWeekday, error) {
+	var weekdays []rruleWeekday
+
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < len("MO") {
+			return nil, fmt.Errorf("%w: %q is not a weekday", ErrRRuleParse, item)
+		}
+
+		numberText, code := item[:len(item)-len("MO")], item[len(item)-len("MO"):]
+
+		weekday := slices.Index(weekdayCodes, code)
+		if weekday < 0 {
+			return nil, fmt.Errorf("%w: %q is not a weekday", ErrRRuleParse, item)
+		}
+
+		number := 0
+
+		if numberText != "" {
+			numbers, err := parseRRuleList(numberText, 1, maxWeekNo, true)
+			if err != nil {
+				return nil, err
+			}
+
+			number = numbers[0]
+		}
+
+		weekdays = append(weekdays, rruleWeekday{n: number, weekday: time.Weekday(weekday)})
+	}
+
+	return weekdays, nil
+}
+
+func formatRRuleNumber(number int) string {
+	if number == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(number)
+}
+
+func formatRRuleList(numbers []int) string {
+	items := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		items = append(items, strconv.Itoa(number))
+	}
+
+	return strings.Join(items, ",")
+}
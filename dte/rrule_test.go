@@ -0,0 +1,331 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseRRule() {
+	rule, err := dte.ParseRRule("RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3")
+	if err != nil {
+		return
+	}
+
+	start := time.Date(2024, time.January, 31, 17, 0, 0, 0, time.UTC)
+
+	for occurrence := range rule.Occurrences(start) {
+		fmt.Println(occurrence.Format(time.DateTime))
+	}
+
+	fmt.Println(rule)
+
+	// Output:
+	// 2024-01-31 17:00:00
+	// 2024-02-29 17:00:00
+	// 2024-03-29 17:00:00
+	// FREQ=MONTHLY;COUNT=3;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1
+}
+
+func ExampleRRule_json() {
+	type Event struct {
+		Rule dte.RRule `json:"rule"`
+	}
+
+	var event Event
+
+	err := json.Unmarshal([]byte(`{"rule":"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;RSCALE=GREGORIAN;SKIP=BACKWARD"}`), &event)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled))
+
+	// Output: {"rule":"FREQ=YEARLY;BYMONTHDAY=29;BYMONTH=2;RSCALE=GREGORIAN;SKIP=BACKWARD"}
+}
+
+func TestRRuleOccurrences(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule  string
+		start string
+		want  []string
+	}{
+		{
+			rule:  "FREQ=MONTHLY;BYDAY=2TU;COUNT=4",
+			start: "2024-01-09",
+			want:  []string{"2024-01-09", "2024-02-13", "2024-03-12", "2024-04-09"},
+		},
+		{
+			rule:  "FREQ=YEARLY;COUNT=3",
+			start: "2024-02-29",
+			want:  []string{"2024-02-29", "2028-02-29", "2032-02-29"},
+		},
+		{
+			rule:  "FREQ=YEARLY;COUNT=3;RSCALE=GREGORIAN;SKIP=BACKWARD",
+			start: "2024-02-29",
+			want:  []string{"2024-02-29", "2025-02-28", "2026-02-28"},
+		},
+		{
+			rule:  "FREQ=YEARLY;COUNT=3;RSCALE=GREGORIAN;SKIP=FORWARD",
+			start: "2024-02-29",
+			want:  []string{"2024-02-29", "2025-03-01", "2026-03-01"},
+		},
+		{
+			rule:  "FREQ=MONTHLY;COUNT=3",
+			start: "2024-01-31",
+			want:  []string{"2024-01-31", "2024-03-31", "2024-05-31"},
+		},
+		{
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;UNTIL=20240201",
+			start: "2024-01-02",
+			want:  []string{"2024-01-02", "2024-01-04", "2024-01-16", "2024-01-18", "2024-01-30", "2024-02-01"},
+		},
+		{
+			rule:  "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			start: "1997-08-05",
+			want:  []string{"1997-08-05", "1997-08-10", "1997-08-19", "1997-08-24"},
+		},
+		{
+			rule:  "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			start: "1997-08-05",
+			want:  []string{"1997-08-05", "1997-08-17", "1997-08-19", "1997-08-31"},
+		},
+		{
+			rule:  "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3",
+			start: "1997-05-12",
+			want:  []string{"1997-05-12", "1998-05-11", "1999-05-17"},
+		},
+		{
+			rule:  "FREQ=YEARLY;INTERVAL=3;COUNT=4;BYYEARDAY=1,100,200",
+			start: "1997-01-01",
+			want:  []string{"1997-01-01", "1997-04-10", "1997-07-19", "2000-01-01"},
+		},
+		{
+			rule:  "FREQ=YEARLY;BYDAY=20MO;COUNT=3",
+			start: "1997-05-19",
+			want:  []string{"1997-05-19", "1998-05-18", "1999-05-17"},
+		},
+		{
+			rule:  "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3",
+			start: "1998-02-13",
+			want:  []string{"1998-02-13", "1998-03-13", "1998-11-13"},
+		},
+		{
+			rule:  "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8;COUNT=3",
+			start: "1996-11-05",
+			want:  []string{"1996-11-05", "2000-11-07", "2004-11-02"},
+		},
+		{
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-3;COUNT=3",
+			start: "1997-09-28",
+			want:  []string{"1997-09-28", "1997-10-29", "1997-11-28"},
+		},
+		{
+			rule:  "FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3",
+			start: "1997-09-04",
+			want:  []string{"1997-09-04", "1997-10-07", "1997-11-06"},
+		},
+		{
+			rule:  "FREQ=DAILY;INTERVAL=10;COUNT=5",
+			start: "1997-09-02",
+			want:  []string{"1997-09-02", "1997-09-12", "1997-09-22", "1997-10-02", "1997-10-12"},
+		},
+		{
+			rule:  "FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10;COUNT=5",
+			start: "1997-09-02T09:00:00Z",
+			want: []string{
+				"1997-09-02T09:00:00Z", "1997-09-02T09:20:00Z", "1997-09-02T09:40:00Z", "1997-09-02T10:00:00Z",
+				"1997-09-02T10:20:00Z",
+			},
+		},
+		{
+			rule:  "FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30;COUNT=3",
+			start: "2024-01-01T09:30:00Z",
+			want:  []string{"2024-01-01T09:30:00Z", "2024-01-01T17:30:00Z", "2024-01-02T09:30:00Z"},
+		},
+		{
+			rule:  "FREQ=DAILY;UNTIL=20231231",
+			start: "2024-01-01",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := dte.ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule() error = %v", err)
+			}
+
+			start, err := dte.ParseISODate(tt.start)
+			if err != nil {
+				t.Fatalf("ParseISODate() error = %v", err)
+			}
+
+			var got []string
+
+			for occurrence := range rule.Occurrences(start.Time) {
+				if len(got) > len(tt.want) {
+					break
+				}
+
+				got = append(got, formatOccurrence(occurrence))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRuleOccurrencesInTimeZone(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		want  []string
+	}{
+		{
+			name:  "daily keeps the wall clock",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: time.Date(2024, time.March, 9, 9, 0, 0, 0, newYork),
+			want:  []string{"2024-03-09T09:00:00-05:00", "2024-03-10T09:00:00-04:00", "2024-03-11T09:00:00-04:00"},
+		},
+		{
+			name:  "skipped wall clock moves forward",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: time.Date(2024, time.March, 9, 2, 30, 0, 0, newYork),
+			want:  []string{"2024-03-09T02:30:00-05:00", "2024-03-10T03:30:00-04:00", "2024-03-11T02:30:00-04:00"},
+		},
+		{
+			name:  "hourly steps in elapsed time",
+			rule:  "FREQ=HOURLY;COUNT=3",
+			start: time.Date(2024, time.March, 31, 0, 0, 0, 0, london),
+			want:  []string{"2024-03-31T00:00:00Z", "2024-03-31T02:00:00+01:00", "2024-03-31T03:00:00+01:00"},
+		},
+		{
+			name:  "until in UTC",
+			rule:  "FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T210000Z",
+			start: time.Date(1997, time.September, 2, 9, 0, 0, 0, newYork),
+			want:  []string{"1997-09-02T09:00:00-04:00", "1997-09-02T12:00:00-04:00", "1997-09-02T15:00:00-04:00"},
+		},
+		{
+			name:  "floating until",
+			rule:  "FREQ=DAILY;UNTIL=20241104T090000",
+			start: time.Date(2024, time.November, 2, 9, 0, 0, 0, newYork),
+			want:  []string{"2024-11-02T09:00:00-04:00", "2024-11-03T09:00:00-05:00", "2024-11-04T09:00:00-05:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := dte.ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule() error = %v", err)
+			}
+
+			var got []string
+
+			for occurrence := range rule.Occurrences(tt.start) {
+				if len(got) > len(tt.want) {
+					break
+				}
+
+				got = append(got, occurrence.Format(time.RFC3339))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr error
+	}{
+		{input: "RRULE:FREQ=WEEKLY;BYDAY=TU,TH;INTERVAL=2", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH"},
+		{input: "freq=monthly;byday=-1fr", want: "FREQ=MONTHLY;BYDAY=-1FR"},
+		{input: "FREQ=DAILY;UNTIL=20240101T120000Z", want: "FREQ=DAILY;UNTIL=20240101T120000Z"},
+		{input: "FREQ=DAILY;UNTIL=20240101T120000", want: "FREQ=DAILY;UNTIL=20240101T120000"},
+		{input: "FREQ=YEARLY;WKST=SU;BYWEEKNO=1,-1", want: "FREQ=YEARLY;BYWEEKNO=1,-1;WKST=SU"},
+		{input: "", wantErr: dte.ErrRRuleParse},
+		{input: "INTERVAL=2", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;FREQ=DAILY", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=FORTNIGHTLY", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;COUNT=2;UNTIL=20240101", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;COUNT=0", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;UNTIL=2024", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=WEEKLY;BYDAY=2TU", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=MONTHLY;BYYEARDAY=1", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=MONTHLY;BYWEEKNO=1", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;BYSETPOS=1", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;BYHOUR=24", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=MONTHLY;BYMONTHDAY=0", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=MONTHLY;BYDAY=XX", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=YEARLY;SKIP=BACKWARD", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=YEARLY;RSCALE=HEBREW", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;WKST=XX", wantErr: dte.ErrRRuleParse},
+		{input: "FREQ=DAILY;X-NAME=1", wantErr: dte.ErrRRuleParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseRRule(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseRRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+
+			reparsed, err := dte.ParseRRule(got.String())
+			if err != nil || reparsed.String() != tt.want {
+				t.Errorf("ParseRRule(String()) = %v, %v, want %v", reparsed, err, tt.want)
+			}
+		})
+	}
+}
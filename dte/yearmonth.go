@@ -0,0 +1,228 @@
+package dte
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrYearMonthParse = errors.New("year month does not follow the yyyy-mm, mm/yy, mm/yyyy or month yyyy format")
+	ErrYearMonthRange = errors.New("year month is outside of 0001-01 to 9999-12")
+)
+
+const (
+	YearMonthOnly = "2006-01"
+
+	monthsPerYear = 12
+	// yearMonthIntBase is the multiplier of the year in the yyyymm integer form.
+	yearMonthIntBase = 100
+	// cardExpiryCentury is added to the two digit years of the mm/yy format, which Go maps to 1969 to 2068.
+	cardExpiryCentury = 2000
+	minYear           = 1
+	maxYear           = 9999
+)
+
+// yearMonthAcceptableFormats are the layouts ParseYearMonth tries in order.
+var yearMonthAcceptableFormats = []string{ //nolint:gochecknoglobals
+	YearMonthOnly,
+	"01/06",
+	"01/2006",
+	"January 2006",
+	"Jan 2006",
+}
+
+// YearMonth is a month of a year without a day, such as a billing period or a card expiry. Its zero value is no
+// month at all.
+type YearMonth struct { //nolint:recvcheck
+	year  int
+	month time.Month
+}
+
+// NewYearMonth returns the month of the year, which must be between 0001-01 and 9999-12.
+func NewYearMonth(year int, month time.Month) (YearMonth, error) {
+	if year < minYear || year > maxYear || month < time.January || month > time.December {
+		return YearMonth{}, fmt.Errorf("%w: %04d-%02d", ErrYearMonthRange, year, month)
+	}
+
+	return YearMonth{year: year, month: month}, nil
+}
+
+// YearMonthOf returns the month of the date.
+func YearMonthOf(d Date) YearMonth {
+	return YearMonth{year: d.Year(), month: d.Month()}
+}
+
+// YearMonthFromInt returns the month of an integer in the yyyymm form, such as 202401.
+func YearMonthFromInt(yyyymm int) (YearMonth, error) {
+	return NewYearMonth(yyyymm/yearMonthIntBase, time.Month(yyyymm%yearMonthIntBase))
+}
+
+// ParseYearMonth parses a month in the yyyy-mm, mm/yy, mm/yyyy, "January 2024" or "Jan 2024" format. Month names
+// are not case sensitive and the two digit years of mm/yy are 2000 to 2099, as on card expiries.
+func ParseYearMonth(s string) (YearMonth, error) {
+	s = strings.TrimSpace(s)
+
+	for _, layout := range yearMonthAcceptableFormats {
+		parsed, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+
+		year := parsed.Year()
+		if layout == "01/06" {
+			year = cardExpiryCentury + year%yearsPerCentury
+		}
+
+		return NewYearMonth(year, parsed.Month())
+	}
+
+	return YearMonth{}, fmt.Errorf("%w: %q", ErrYearMonthParse, s)
+}
+
+// IsZero reports whether y is the zero YearMonth.
+func (y YearMonth) IsZero() bool {
+	return y.month == 0
+}
+
+// Year returns the year.
+func (y YearMonth) Year() int {
+	return y.year
+}
+
+// Month returns the month of the year.
+func (y YearMonth) Month() time.Month {
+	return y.month
+}
+
+// Int returns the month in the yyyymm integer form, such as 202401.
+func (y YearMonth) Int() int {
+	return y.year*yearMonthIntBase + int(y.month)
+}
+
+// FirstDay returns the first day of the month.
+func (y YearMonth) FirstDay() Date {
+	return Date{time.Date(y.year, y.month, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// LastDay returns the last day of the month.
+func (y YearMonth) LastDay() Date {
+	return Date{time.Date(y.year, y.month+1, 0, 0, 0, 0, 0, time.UTC)}
+}
+
+// Days returns the number of days in the month.
+func (y YearMonth) Days() int {
+	return y.LastDay().Day()
+}
+
+// Contains reports whether the date is in the month.
+func (y YearMonth) Contains(d Date) bool {
+	return !y.IsZero() && d.Year() == y.year && d.Month() == y.month
+}
+
+// AddMonths returns the month n months after y, or before it for a negative n.
+func (y YearMonth) AddMonths(n int) YearMonth {
+	months := y.year*monthsPerYear + int(y.month) - 1 + n
+	year := floorDiv(months, monthsPerYear)
+
+	return YearMonth{year: year, month: time.Month(months-year*monthsPerYear) + time.January}
+}
+
+// AddYears returns the same month n years after y, or before it for a negative n.
+func (y YearMonth) AddYears(n int) YearMonth {
+	return YearMonth{year: y.year + n, month: y.month}
+}
+
+// Diff returns the number of months from other to y, so 2024-03 minus 2023-12 is 3.
+func (y YearMonth) Diff(other YearMonth) int {
+	return (y.year-other.year)*monthsPerYear + int(y.month-other.month)
+}
+
+// Compare returns -1 when y is before other, 1 when it is after and 0 when both are the same month.
+func (y YearMonth) Compare(other YearMonth) int {
+	diff := y.Diff(other)
+
+	switch {
+	case diff < 0:
+		return -1
+	case diff > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Before reports whether y is before other.
+func (y YearMonth) Before(other YearMonth) bool {
+	return y.Compare(other) < 0
+}
+
+// After reports whether y is after other.
+func (y YearMonth) After(other YearMonth) bool {
+	return y.Compare(other) > 0
+}
+
+// Format formats the first day of the month with a time layout, for example "01/06" for a card expiry.
+func (y YearMonth) Format(layout string) string {
+	return y.FirstDay().Format(layout)
+}
+
+// String returns the month in the yyyy-mm format, or an empty string for the zero YearMonth.
+func (y YearMonth) String() string {
+	if y.IsZero() {
+		return ""
+	}
+
+	return y.Format(YearMonthOnly)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The month is a quoted string in the yyyy-mm format, or null for the zero YearMonth.
+func (y YearMonth) MarshalJSON() ([]byte, error) {
+	if y.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + y.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The month must be a quoted string in any format ParseYearMonth accepts, null leaves it unchanged.
+func (y *YearMonth) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("YearMonth.UnmarshalJSON: %w: input is not a JSON string", ErrYearMonthParse)
+	}
+
+	return y.UnmarshalText(data[len(`"`) : len(data)-len(`"`)])
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (y YearMonth) MarshalText() ([]byte, error) {
+	return []byte(y.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (y *YearMonth) UnmarshalText(data []byte) error {
+	parsed, err := ParseYearMonth(string(data))
+	if err != nil {
+		return err
+	}
+
+	*y = parsed
+
+	return nil
+}
+
+// floorDiv returns a divided by b rounded towards negative infinity.
+func floorDiv(a int, b int) int {
+	if a < 0 && a%b != 0 {
+		return a/b - 1
+	}
+
+	return a / b
+}
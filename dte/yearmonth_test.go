@@ -0,0 +1,187 @@
+package dte_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+func ExampleParseYearMonth() {
+	expiry, err := dte.ParseYearMonth("01/27")
+	if err != nil {
+		return
+	}
+
+	billing, err := dte.ParseYearMonth("November 2024")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(expiry, expiry.LastDay(), expiry.Diff(billing), billing.AddMonths(3), expiry.Format("01/06"))
+
+	// Output: 2027-01 2027-01-31 26 2025-02 01/27
+}
+
+func ExampleYearMonth_json() {
+	type Invoice struct {
+		Period dte.YearMonth `json:"period"`
+	}
+
+	var invoice Invoice
+
+	err := json.Unmarshal([]byte(`{"period":"Feb 2024"}`), &invoice)
+	if err != nil {
+		return
+	}
+
+	marshaled, err := json.Marshal(invoice)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(marshaled), invoice.Period.Days())
+
+	// Output: {"period":"2024-02"} 29
+}
+
+func TestParseYearMonth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr error
+	}{
+		{input: "2024-01", want: "2024-01"},
+		{input: "01/24", want: "2024-01"},
+		{input: "12/99", want: "2099-12"},
+		{input: "01/2024", want: "2024-01"},
+		{input: "January 2024", want: "2024-01"},
+		{input: "january 2024", want: "2024-01"},
+		{input: " Sep 1999 ", want: "1999-09"},
+		{input: "2024-13", wantErr: dte.ErrYearMonthParse},
+		{input: "1/24", wantErr: dte.ErrYearMonthParse},
+		{input: "2024-01-01", wantErr: dte.ErrYearMonthParse},
+		{input: "0000-01", wantErr: dte.ErrYearMonthRange},
+		{input: "", wantErr: dte.ErrYearMonthParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dte.ParseYearMonth(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseYearMonth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("ParseYearMonth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestYearMonthArithmetic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		start     string
+		months    int
+		want      string
+		wantFirst string
+		wantLast  string
+	}{
+		{name: "same year", start: "2024-01", months: 1, want: "2024-02", wantFirst: "2024-02-01", wantLast: "2024-02-29"},
+		{name: "next year", start: "2024-11", months: 3, want: "2025-02", wantFirst: "2025-02-01", wantLast: "2025-02-28"},
+		{name: "last year", start: "2024-01", months: -1, want: "2023-12", wantFirst: "2023-12-01", wantLast: "2023-12-31"},
+		{name: "years back", start: "2024-03", months: -27, want: "2021-12", wantFirst: "2021-12-01", wantLast: "2021-12-31"},
+		{name: "zero", start: "2024-04", months: 0, want: "2024-04", wantFirst: "2024-04-01", wantLast: "2024-04-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start, err := dte.ParseYearMonth(tt.start)
+			if err != nil {
+				t.Fatalf("ParseYearMonth() error = %v", err)
+			}
+
+			got := start.AddMonths(tt.months)
+			if got.String() != tt.want {
+				t.Errorf("AddMonths() = %v, want %v", got, tt.want)
+			}
+
+			if got.Diff(start) != tt.months || got.Compare(start) != compareInts(tt.months, 0) {
+				t.Errorf("Diff() = %v, Compare() = %v", got.Diff(start), got.Compare(start))
+			}
+
+			if got.FirstDay().String() != tt.wantFirst || got.LastDay().String() != tt.wantLast {
+				t.Errorf("FirstDay(), LastDay() = %v, %v", got.FirstDay(), got.LastDay())
+			}
+
+			if !got.Contains(got.LastDay()) || got.Contains(got.AddMonths(1).FirstDay()) {
+				t.Errorf("Contains() is not correct for %v", got)
+			}
+		})
+	}
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestYearMonthConversion(t *testing.T) {
+	t.Parallel()
+
+	yearMonth, err := dte.NewYearMonth(2024, time.March)
+	if err != nil {
+		t.Fatalf("NewYearMonth() error = %v", err)
+	}
+
+	fromInt, err := dte.YearMonthFromInt(yearMonth.Int())
+	if err != nil || fromInt != yearMonth || yearMonth.Int() != 202403 {
+		t.Errorf("YearMonthFromInt(%d) = %v, %v", yearMonth.Int(), fromInt, err)
+	}
+
+	onlyDate, err := dte.NewDate("2024-03-15")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	if dte.YearMonthOf(onlyDate) != yearMonth || !yearMonth.AddYears(-1).Before(yearMonth) {
+		t.Errorf("YearMonthOf() = %v, want %v", dte.YearMonthOf(onlyDate), yearMonth)
+	}
+
+	for _, yyyymm := range []int{202400, 202413, 99999, 0} {
+		_, err = dte.YearMonthFromInt(yyyymm)
+		if !errors.Is(err, dte.ErrYearMonthRange) {
+			t.Errorf("YearMonthFromInt(%d) error = %v, wantErr %v", yyyymm, err, dte.ErrYearMonthRange)
+		}
+	}
+
+	var zero dte.YearMonth
+
+	marshaled, err := json.Marshal(zero)
+	if err != nil || string(marshaled) != "null" || !zero.IsZero() {
+		t.Errorf("Marshal() = %s, %v, want null", marshaled, err)
+	}
+
+	err = json.Unmarshal([]byte(`202403`), &zero)
+	if !errors.Is(err, dte.ErrYearMonthParse) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, dte.ErrYearMonthParse)
+	}
+}
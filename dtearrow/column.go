@@ -0,0 +1,125 @@
+package dtearrow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/parquet/schema"
+)
+
+var (
+	ErrRepeatedColumn       = errors.New("repeated parquet columns are not supported")
+	ErrValidLength          = errors.New("valid slice length does not match the number of values")
+	ErrNullInRequiredColumn = errors.New("null value passed for a required parquet column")
+	ErrColumnWrite          = errors.New("failed to write parquet column")
+	ErrColumnRead           = errors.New("failed to read parquet column")
+)
+
+const readBatchSize = 1024
+
+// writeColumn writes the valid values of a flat column, deriving the definition levels from valid.
+func writeColumn[T int32 | int64](
+	column *schema.Column,
+	values []T,
+	valid []bool,
+	writeBatch func(values []T, defLevels []int16, repLevels []int16) (int64, error),
+) error {
+	if column.MaxRepetitionLevel() > 0 {
+		return fmt.Errorf("%w: %s", ErrRepeatedColumn, column.Name())
+	}
+
+	if valid != nil && len(valid) != len(values) {
+		return fmt.Errorf("%w: %d values, %d valid", ErrValidLength, len(values), len(valid))
+	}
+
+	maxDefinitionLevel := column.MaxDefinitionLevel()
+
+	var defLevels []int16
+
+	defined := values
+
+	if maxDefinitionLevel > 0 {
+		defLevels = make([]int16, len(values))
+		defined = make([]T, 0, len(values))
+
+		for i, v := range values {
+			if valid != nil && !valid[i] {
+				defLevels[i] = maxDefinitionLevel - 1
+
+				continue
+			}
+
+			defLevels[i] = maxDefinitionLevel
+			defined = append(defined, v)
+		}
+	} else {
+		for _, isValid := range valid {
+			if !isValid {
+				return fmt.Errorf("%w: %s", ErrNullInRequiredColumn, column.Name())
+			}
+		}
+	}
+
+	_, err := writeBatch(defined, defLevels, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrColumnWrite, err)
+	}
+
+	return nil
+}
+
+// readColumn reads every remaining value of a flat column. Values are aligned with rows, null rows hold the zero
+// value, and valid is nil for required columns.
+func readColumn[T int32 | int64](
+	column *schema.Column,
+	hasNext func() bool,
+	readBatch func(batchSize int64, values []T, defLvls []int16, repLvls []int16) (int64, int, error),
+) ([]T, []bool, error) {
+	if column.MaxRepetitionLevel() > 0 {
+		return nil, nil, fmt.Errorf("%w: %s", ErrRepeatedColumn, column.Name())
+	}
+
+	maxDefinitionLevel := column.MaxDefinitionLevel()
+
+	var (
+		values []T
+		valid  []bool
+	)
+
+	if maxDefinitionLevel > 0 {
+		valid = []bool{}
+	}
+
+	batch := make([]T, readBatchSize)
+	defLevels := make([]int16, readBatchSize)
+
+	for hasNext() {
+		total, _, err := readBatch(readBatchSize, batch, defLevels, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", ErrColumnRead, err)
+		}
+
+		if maxDefinitionLevel == 0 {
+			values = append(values, batch[:total]...)
+
+			continue
+		}
+
+		next := 0
+
+		for _, level := range defLevels[:total] {
+			if level < maxDefinitionLevel {
+				values = append(values, 0)
+				valid = append(valid, false)
+
+				continue
+			}
+
+			values = append(values, batch[next])
+			valid = append(valid, true)
+			next++
+		}
+	}
+
+	return values, valid, nil
+}
@@ -0,0 +1,120 @@
+package dtearrow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var ErrNotDateColumn = errors.New("parquet column is not an INT32 column with the DATE logical type")
+
+const secondsPerDay = 24 * 60 * 60
+
+// DateToDate32 converts the date to the number of days since 1970-01-01 used by Arrow date32 and Parquet DATE.
+func DateToDate32(d dte.Date) arrow.Date32 {
+	midnight := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+
+	return arrow.Date32(midnight.Unix() / secondsPerDay)
+}
+
+// Date32ToDate converts a number of days since 1970-01-01 to a date.
+func Date32ToDate(v arrow.Date32) dte.Date {
+	return dte.Date{Time: time.Unix(int64(v)*secondsPerDay, 0).UTC()}
+}
+
+// DateBuilder builds Arrow date32 arrays from dates.
+type DateBuilder struct {
+	*array.Date32Builder
+}
+
+func NewDateBuilder(mem memory.Allocator) *DateBuilder {
+	return &DateBuilder{Date32Builder: array.NewDate32Builder(mem)}
+}
+
+// AppendDate appends a date to the builder.
+func (b *DateBuilder) AppendDate(d dte.Date) {
+	b.Append(DateToDate32(d))
+}
+
+// AppendDates appends the dates to the builder. valid follows [array.Date32Builder.AppendValues],
+// nil marks every date as valid.
+func (b *DateBuilder) AppendDates(dates []dte.Date, valid []bool) {
+	values := make([]arrow.Date32, len(dates))
+	for i, d := range dates {
+		values[i] = DateToDate32(d)
+	}
+
+	b.AppendValues(values, valid)
+}
+
+// DateValues returns the dates of an Arrow date32 array. Null entries are the zero Date, use arr.IsNull to
+// tell them apart.
+func DateValues(arr *array.Date32) []dte.Date {
+	dates := make([]dte.Date, arr.Len())
+
+	for i, v := range arr.Date32Values() {
+		if arr.IsValid(i) {
+			dates[i] = Date32ToDate(v)
+		}
+	}
+
+	return dates
+}
+
+// DateNode returns a Parquet INT32 column node annotated with the DATE logical type.
+func DateNode(name string, repetition parquet.Repetition, fieldID int32) (*schema.PrimitiveNode, error) {
+	node, err := schema.NewPrimitiveNodeLogical(
+		name, repetition, schema.DateLogicalType{}, parquet.Types.Int32, 0, fieldID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("DateNode: %w", err)
+	}
+
+	return node, nil
+}
+
+// WriteDateColumn writes the dates to a Parquet DATE column. valid marks the null entries of an optional column,
+// nil marks every date as valid.
+func WriteDateColumn(w *file.Int32ColumnChunkWriter, dates []dte.Date, valid []bool) error {
+	if _, ok := w.Descr().LogicalType().(schema.DateLogicalType); !ok {
+		return fmt.Errorf("%w: %s", ErrNotDateColumn, w.Descr().Name())
+	}
+
+	values := make([]int32, len(dates))
+	for i, d := range dates {
+		values[i] = int32(DateToDate32(d))
+	}
+
+	return writeColumn(w.Descr(), values, valid, w.WriteBatch)
+}
+
+// ReadDateColumn reads every remaining value of a Parquet DATE column. The returned valid slice is nil for
+// required columns, null entries of optional columns are the zero Date.
+func ReadDateColumn(r *file.Int32ColumnChunkReader) ([]dte.Date, []bool, error) {
+	if _, ok := r.Descriptor().LogicalType().(schema.DateLogicalType); !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrNotDateColumn, r.Descriptor().Name())
+	}
+
+	values, valid, err := readColumn(r.Descriptor(), r.HasNext, r.ReadBatch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dates := make([]dte.Date, len(values))
+
+	for i, v := range values {
+		if valid == nil || valid[i] {
+			dates[i] = Date32ToDate(arrow.Date32(v))
+		}
+	}
+
+	return dates, valid, nil
+}
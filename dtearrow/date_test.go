@@ -0,0 +1,147 @@
+package dtearrow_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtearrow"
+)
+
+func ExampleDateBuilder() {
+	builder := dtearrow.NewDateBuilder(memory.NewGoAllocator())
+	defer builder.Release()
+
+	onlyDate, err := dte.NewDate("2006-01-02")
+	if err != nil {
+		return
+	}
+
+	builder.AppendDate(onlyDate)
+	builder.AppendNull()
+
+	arr := builder.NewDate32Array()
+	defer arr.Release()
+
+	fmt.Println(arr.Value(0), dtearrow.DateValues(arr)[0], arr.IsNull(1))
+
+	// Output: 13150 2006-01-02 true
+}
+
+func TestDateToDate32(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputDate string
+		want      arrow.Date32
+	}{
+		{
+			name:      "epoch",
+			inputDate: "1970-01-01",
+			want:      0,
+		},
+		{
+			name:      "before epoch",
+			inputDate: "1969-12-31",
+			want:      -1,
+		},
+		{
+			name:      "full timestamp keeps the date",
+			inputDate: "2006-01-02T23:04:05-05:00",
+			want:      13150,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.inputDate)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			got := dtearrow.DateToDate32(onlyDate)
+			if got != tt.want {
+				t.Errorf("DateToDate32() = %v, want %v", got, tt.want)
+			}
+
+			if back := dtearrow.Date32ToDate(got); back.String() != onlyDate.String() {
+				t.Errorf("Date32ToDate() = %v, want %v", back, onlyDate)
+			}
+		})
+	}
+}
+
+func TestDateColumn(t *testing.T) {
+	t.Parallel()
+
+	dates := []dte.Date{
+		func() dte.Date { d, _ := dte.NewDate("2006-01-02"); return d }(), //nolint:nlreturn
+		{},
+		func() dte.Date { d, _ := dte.NewDate("1969-12-31"); return d }(), //nolint:nlreturn
+	}
+	valid := []bool{true, false, true}
+
+	for _, repetition := range []parquet.Repetition{parquet.Repetitions.Required, parquet.Repetitions.Optional} {
+		t.Run(repetition.String(), func(t *testing.T) {
+			t.Parallel()
+
+			node, err := dtearrow.DateNode("only_date", repetition, -1)
+			if err != nil {
+				t.Fatalf("DateNode() error = %v", err)
+			}
+
+			wantValid := valid
+			if repetition == parquet.Repetitions.Required {
+				wantValid = nil
+			}
+
+			reader := RoundTrip(t, node, func(w file.ColumnChunkWriter) error {
+				return dtearrow.WriteDateColumn(w.(*file.Int32ColumnChunkWriter), dates, wantValid) //nolint:forcetypeassert
+			})
+
+			got, gotValid, err := dtearrow.ReadDateColumn(reader.(*file.Int32ColumnChunkReader)) //nolint:forcetypeassert
+			if err != nil {
+				t.Fatalf("ReadDateColumn() error = %v", err)
+			}
+
+			if !slices.Equal(gotValid, wantValid) {
+				t.Errorf("ReadDateColumn() valid = %v, want %v", gotValid, wantValid)
+			}
+
+			for i := range dates {
+				if (wantValid == nil || wantValid[i]) && got[i] != dates[i] {
+					t.Errorf("ReadDateColumn()[%d] = %v, want %v", i, got[i], dates[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWriteDateColumnNullInRequired(t *testing.T) {
+	t.Parallel()
+
+	node, err := dtearrow.DateNode("only_date", parquet.Repetitions.Required, -1)
+	if err != nil {
+		t.Fatalf("DateNode() error = %v", err)
+	}
+
+	RoundTrip(t, node, func(w file.ColumnChunkWriter) error {
+		writer := w.(*file.Int32ColumnChunkWriter) //nolint:forcetypeassert
+
+		err := dtearrow.WriteDateColumn(writer, []dte.Date{{}}, []bool{false})
+		if !errors.Is(err, dtearrow.ErrNullInRequiredColumn) {
+			t.Errorf("WriteDateColumn() error = %v, wantErr %v", err, dtearrow.ErrNullInRequiredColumn)
+		}
+
+		return nil
+	})
+}
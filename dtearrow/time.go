@@ -0,0 +1,243 @@
+package dtearrow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrTimeUnit       = errors.New("unsupported time unit")
+	ErrTimeOutOfRange = errors.New("time value is outside of a single day")
+	ErrNotTimeColumn  = errors.New("parquet column is not an INT32 or INT64 column with the TIME logical type")
+)
+
+// TimeToTime64 converts the time of day in UTC to an Arrow time64 in unit, which must be Microsecond or Nanosecond.
+// Precision finer than the unit is truncated.
+func TimeToTime64(t dte.Time, unit arrow.TimeUnit) (arrow.Time64, error) {
+	if unit != arrow.Microsecond && unit != arrow.Nanosecond {
+		return 0, fmt.Errorf("%w: time64 %s", ErrTimeUnit, unit)
+	}
+
+	return arrow.Time64(int64(sinceMidnight(t)) / int64(unit.Multiplier())), nil
+}
+
+// Time64ToTime converts an Arrow time64 in unit, which must be Microsecond or Nanosecond, to a time in UTC.
+func Time64ToTime(v arrow.Time64, unit arrow.TimeUnit) (dte.Time, error) {
+	if unit != arrow.Microsecond && unit != arrow.Nanosecond {
+		return dte.Time{}, fmt.Errorf("%w: time64 %s", ErrTimeUnit, unit)
+	}
+
+	if int64(v) >= int64(24*time.Hour/unit.Multiplier()) {
+		return dte.Time{}, fmt.Errorf("%w: %d %s", ErrTimeOutOfRange, v, unit)
+	}
+
+	return timeFromMidnight(time.Duration(int64(v) * int64(unit.Multiplier())))
+}
+
+// TimeBuilder builds Arrow time64 arrays from times.
+type TimeBuilder struct {
+	*array.Time64Builder
+	unit arrow.TimeUnit
+}
+
+// NewTimeBuilder returns a builder for time64 arrays in unit, which must be Microsecond or Nanosecond.
+func NewTimeBuilder(mem memory.Allocator, unit arrow.TimeUnit) (*TimeBuilder, error) {
+	if unit != arrow.Microsecond && unit != arrow.Nanosecond {
+		return nil, fmt.Errorf("%w: time64 %s", ErrTimeUnit, unit)
+	}
+
+	return &TimeBuilder{Time64Builder: array.NewTime64Builder(mem, &arrow.Time64Type{Unit: unit}), unit: unit}, nil
+}
+
+// AppendTime appends a time to the builder.
+func (b *TimeBuilder) AppendTime(t dte.Time) {
+	v, _ := TimeToTime64(t, b.unit) // The unit is checked by NewTimeBuilder.
+
+	b.Append(v)
+}
+
+// AppendTimes appends the times to the builder. valid follows [array.Time64Builder.AppendValues],
+// nil marks every time as valid.
+func (b *TimeBuilder) AppendTimes(times []dte.Time, valid []bool) {
+	values := make([]arrow.Time64, len(times))
+	for i, t := range times {
+		values[i], _ = TimeToTime64(t, b.unit) // The unit is checked by NewTimeBuilder.
+	}
+
+	b.AppendValues(values, valid)
+}
+
+// TimeValues returns the times of an Arrow time64 array. Null entries are the zero Time, use arr.IsNull to
+// tell them apart.
+func TimeValues(arr *array.Time64) ([]dte.Time, error) {
+	unit := arr.DataType().(*arrow.Time64Type).Unit //nolint:forcetypeassert
+	times := make([]dte.Time, arr.Len())
+
+	for i, v := range arr.Time64Values() {
+		if !arr.IsValid(i) {
+			continue
+		}
+
+		converted, err := Time64ToTime(v, unit)
+		if err != nil {
+			return nil, err
+		}
+
+		times[i] = converted
+	}
+
+	return times, nil
+}
+
+// TimeNode returns a Parquet column node annotated with the TIME logical type in unit. Milliseconds are stored
+// as INT32, microseconds and nanoseconds as INT64. Times are always written in UTC, so isAdjustedToUTC is set.
+func TimeNode(
+	name string, repetition parquet.Repetition, unit schema.TimeUnitType, fieldID int32,
+) (*schema.PrimitiveNode, error) {
+	physicalType := parquet.Types.Int64
+
+	switch unit {
+	case schema.TimeUnitMillis:
+		physicalType = parquet.Types.Int32
+	case schema.TimeUnitMicros, schema.TimeUnitNanos:
+	case schema.TimeUnitUnknown:
+		fallthrough
+	default:
+		return nil, fmt.Errorf("%w: parquet time unit %d", ErrTimeUnit, unit)
+	}
+
+	logicalType := schema.NewTimeLogicalType(true, unit)
+
+	node, err := schema.NewPrimitiveNodeLogical(name, repetition, logicalType, physicalType, 0, fieldID)
+	if err != nil {
+		return nil, fmt.Errorf("TimeNode: %w", err)
+	}
+
+	return node, nil
+}
+
+// WriteTimeColumn writes the times to a Parquet TIME column, using the unit of the column. valid marks the null
+// entries of an optional column, nil marks every time as valid.
+func WriteTimeColumn(w file.ColumnChunkWriter, times []dte.Time, valid []bool) error {
+	unit, err := timeColumnUnit(w.Descr())
+	if err != nil {
+		return err
+	}
+
+	switch writer := w.(type) {
+	case *file.Int32ColumnChunkWriter:
+		values := make([]int32, len(times))
+		for i, t := range times {
+			values[i] = int32(sinceMidnight(t) / unit) //nolint:gosec
+		}
+
+		return writeColumn(w.Descr(), values, valid, writer.WriteBatch)
+	case *file.Int64ColumnChunkWriter:
+		values := make([]int64, len(times))
+		for i, t := range times {
+			values[i] = int64(sinceMidnight(t) / unit)
+		}
+
+		return writeColumn(w.Descr(), values, valid, writer.WriteBatch)
+	default:
+		return fmt.Errorf("%w: %s", ErrNotTimeColumn, w.Descr().Name())
+	}
+}
+
+// ReadTimeColumn reads every remaining value of a Parquet TIME column. Columns that are not adjusted to UTC are
+// read as the same wall clock time in UTC. The returned valid slice is nil for required columns, null entries
+// of optional columns are the zero Time.
+func ReadTimeColumn(r file.ColumnChunkReader) ([]dte.Time, []bool, error) {
+	unit, err := timeColumnUnit(r.Descriptor())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		values []int64
+		valid  []bool
+	)
+
+	switch reader := r.(type) {
+	case *file.Int32ColumnChunkReader:
+		var int32Values []int32
+
+		int32Values, valid, err = readColumn(r.Descriptor(), reader.HasNext, reader.ReadBatch)
+
+		values = make([]int64, len(int32Values))
+		for i, v := range int32Values {
+			values[i] = int64(v)
+		}
+	case *file.Int64ColumnChunkReader:
+		values, valid, err = readColumn(r.Descriptor(), reader.HasNext, reader.ReadBatch)
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrNotTimeColumn, r.Descriptor().Name())
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	times := make([]dte.Time, len(values))
+
+	for i, v := range values {
+		if valid != nil && !valid[i] {
+			continue
+		}
+
+		times[i], err = timeFromMidnight(time.Duration(v) * unit)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return times, valid, nil
+}
+
+// timeColumnUnit returns the duration of one unit of a Parquet TIME column.
+func timeColumnUnit(column *schema.Column) (time.Duration, error) {
+	logicalType, ok := column.LogicalType().(schema.TimeLogicalType)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrNotTimeColumn, column.Name())
+	}
+
+	switch logicalType.TimeUnit() {
+	case schema.TimeUnitMillis:
+		return time.Millisecond, nil
+	case schema.TimeUnitMicros:
+		return time.Microsecond, nil
+	case schema.TimeUnitNanos:
+		return time.Nanosecond, nil
+	case schema.TimeUnitUnknown:
+		fallthrough
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrTimeUnit, column.Name())
+	}
+}
+
+// sinceMidnight returns the time of day in UTC as the duration since midnight.
+func sinceMidnight(t dte.Time) time.Duration {
+	utc := t.UTC()
+	hour, minute, second := utc.Clock()
+
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute +
+		time.Duration(second)*time.Second + time.Duration(utc.Nanosecond())
+}
+
+// timeFromMidnight returns the time in UTC that is the duration after midnight.
+func timeFromMidnight(sinceMidnight time.Duration) (dte.Time, error) {
+	if sinceMidnight < 0 || sinceMidnight >= 24*time.Hour {
+		return dte.Time{}, fmt.Errorf("%w: %s", ErrTimeOutOfRange, sinceMidnight)
+	}
+
+	return dte.Time{Time: time.Date(0, time.January, 1, 0, 0, 0, int(sinceMidnight), time.UTC)}, nil
+}
@@ -0,0 +1,167 @@
+package dtearrow_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtearrow"
+)
+
+func ExampleTimeBuilder() {
+	builder, err := dtearrow.NewTimeBuilder(memory.NewGoAllocator(), arrow.Microsecond)
+	if err != nil {
+		return
+	}
+	defer builder.Release()
+
+	onlyTime, err := dte.NewTime("10:04:05-05:00")
+	if err != nil {
+		return
+	}
+
+	builder.AppendTime(onlyTime)
+
+	arr := builder.NewTime64Array()
+	defer arr.Release()
+
+	times, err := dtearrow.TimeValues(arr)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(arr.Value(0), times[0])
+
+	// Output: 54245000000 15:04:05Z
+}
+
+//nolint:funlen
+func TestTimeToTime64(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputTime dte.Time
+		unit      arrow.TimeUnit
+		want      arrow.Time64
+		wantErr   error
+	}{
+		{
+			name:      "microseconds",
+			inputTime: func() dte.Time { t, _ := dte.NewTime("00:00:01Z"); return t }(), //nolint:nlreturn
+			unit:      arrow.Microsecond,
+			want:      1_000_000,
+			wantErr:   nil,
+		},
+		{
+			name:      "nanoseconds",
+			inputTime: dte.Time{Time: time.Date(0, 1, 1, 0, 0, 1, 5, time.UTC)},
+			unit:      arrow.Nanosecond,
+			want:      1_000_000_005,
+			wantErr:   nil,
+		},
+		{
+			name:      "offset is converted to UTC",
+			inputTime: dte.Time{Time: time.Date(0, 1, 1, 0, 0, 0, 0, time.FixedZone("UTC+1", 3600))},
+			unit:      arrow.Microsecond,
+			want:      23 * 3600 * 1_000_000,
+			wantErr:   nil,
+		},
+		{
+			name:      "seconds are not a time64 unit",
+			inputTime: dte.Time{},
+			unit:      arrow.Second,
+			want:      0,
+			wantErr:   dtearrow.ErrTimeUnit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dtearrow.TimeToTime64(tt.inputTime, tt.unit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("TimeToTime64() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("TimeToTime64() = %v, want %v", got, tt.want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			back, err := dtearrow.Time64ToTime(got, tt.unit)
+			if err != nil {
+				t.Fatalf("Time64ToTime() error = %v", err)
+			}
+
+			const layout = "15:04:05.999999999Z07:00"
+
+			if back.Format(layout) != tt.inputTime.UTC().Format(layout) {
+				t.Errorf("Time64ToTime() = %v, want %v", back, tt.inputTime)
+			}
+		})
+	}
+}
+
+func TestTime64ToTimeOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	_, err := dtearrow.Time64ToTime(arrow.Time64(24*time.Hour/time.Microsecond), arrow.Microsecond)
+	if !errors.Is(err, dtearrow.ErrTimeOutOfRange) {
+		t.Errorf("Time64ToTime() error = %v, wantErr %v", err, dtearrow.ErrTimeOutOfRange)
+	}
+}
+
+func TestTimeColumn(t *testing.T) {
+	t.Parallel()
+
+	times := []dte.Time{
+		func() dte.Time { t, _ := dte.NewTime("15:04:05Z"); return t }(), //nolint:nlreturn
+		{},
+		dte.Time{Time: time.Date(0, 1, 1, 23, 59, 59, 999_000_000, time.UTC)},
+	}
+	valid := []bool{true, false, true}
+
+	for _, unit := range []schema.TimeUnitType{schema.TimeUnitMillis, schema.TimeUnitMicros, schema.TimeUnitNanos} {
+		t.Run(fmt.Sprint(unit), func(t *testing.T) {
+			t.Parallel()
+
+			node, err := dtearrow.TimeNode("only_time", parquet.Repetitions.Optional, unit, -1)
+			if err != nil {
+				t.Fatalf("TimeNode() error = %v", err)
+			}
+
+			reader := RoundTrip(t, node, func(w file.ColumnChunkWriter) error {
+				return dtearrow.WriteTimeColumn(w, times, valid)
+			})
+
+			got, gotValid, err := dtearrow.ReadTimeColumn(reader)
+			if err != nil {
+				t.Fatalf("ReadTimeColumn() error = %v", err)
+			}
+
+			for i := range times {
+				if gotValid[i] != valid[i] {
+					t.Errorf("ReadTimeColumn() valid[%d] = %v, want %v", i, gotValid[i], valid[i])
+				}
+
+				if valid[i] && !got[i].Equal(times[i].Time) {
+					t.Errorf("ReadTimeColumn()[%d] = %v, want %v", i, got[i], times[i])
+				}
+			}
+		})
+	}
+}
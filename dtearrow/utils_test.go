@@ -0,0 +1,54 @@
+package dtearrow_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+)
+
+// RoundTrip writes a single column Parquet file in memory with write and returns a reader for that column.
+func RoundTrip(t *testing.T, node schema.Node, write func(w file.ColumnChunkWriter) error) file.ColumnChunkReader {
+	t.Helper()
+
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, schema.FieldList{node}, -1)
+	if err != nil {
+		t.Fatalf("NewGroupNode() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	writer := file.NewParquetWriter(&buf, root)
+	rowGroup := writer.AppendRowGroup()
+
+	columnWriter, err := rowGroup.NextColumn()
+	if err != nil {
+		t.Fatalf("NextColumn() error = %v", err)
+	}
+
+	err = write(columnWriter)
+	if err != nil {
+		t.Fatalf("write error = %v", err)
+	}
+
+	for _, closer := range []interface{ Close() error }{columnWriter, rowGroup, writer} {
+		err = closer.Close()
+		if err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+	}
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("NewParquetReader() error = %v", err)
+	}
+
+	columnReader, err := reader.RowGroup(0).Column(0)
+	if err != nil {
+		t.Fatalf("Column() error = %v", err)
+	}
+
+	return columnReader
+}
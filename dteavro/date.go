@@ -0,0 +1,57 @@
+package dteavro
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var ErrDateNative = errors.New("native avro value can not be converted to a date")
+
+const secondsPerDay = 24 * 60 * 60
+
+// DateSchema is the Avro schema of the date logical type, an int counting days since 1970-01-01.
+const DateSchema = `{"type":"int","logicalType":"date"}`
+
+// DateToAvro converts the date to the number of days since 1970-01-01 stored by the Avro date logical type.
+func DateToAvro(d dte.Date) int32 {
+	return int32(dateToNative(d).Unix() / secondsPerDay) //nolint:gosec
+}
+
+// DateFromAvro converts a number of days since 1970-01-01 to a date.
+func DateFromAvro(days int32) dte.Date {
+	return dte.Date{Time: time.Unix(int64(days)*secondsPerDay, 0).UTC()}
+}
+
+// dateToNative returns the date as the time.Time at midnight UTC that hamba/avro and goavro encode as a date.
+func dateToNative(d dte.Date) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// dateFromNative converts the value decoded by hamba/avro or goavro for a date, or a raw day count, to a date.
+func dateFromNative(native any) (dte.Date, error) {
+	switch v := native.(type) {
+	case time.Time:
+		return DateFromAvro(DateToAvro(dte.Date{Time: v.UTC()})), nil
+	case int32:
+		return DateFromAvro(v), nil
+	case int:
+		return dateFromDays(int64(v))
+	case int64:
+		return dateFromDays(v)
+	default:
+		return dte.Date{}, fmt.Errorf("%w: %T", ErrDateNative, native)
+	}
+}
+
+// dateFromDays converts a raw day count to a date, when it fits the int Avro stores it in.
+func dateFromDays(days int64) (dte.Date, error) {
+	if days < math.MinInt32 || days > math.MaxInt32 {
+		return dte.Date{}, fmt.Errorf("%w: %d days is out of the int32 range", ErrDateNative, days)
+	}
+
+	return DateFromAvro(int32(days)), nil
+}
@@ -0,0 +1,68 @@
+package dteavro_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteavro"
+)
+
+func ExampleDateToAvro() {
+	onlyDate, err := dte.NewDate("2006-01-02")
+	if err != nil {
+		return
+	}
+
+	days := dteavro.DateToAvro(onlyDate)
+
+	fmt.Println(days, dteavro.DateFromAvro(days))
+
+	// Output: 13150 2006-01-02
+}
+
+func TestDateToAvro(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputDate string
+		want      int32
+	}{
+		{
+			name:      "epoch",
+			inputDate: "1970-01-01",
+			want:      0,
+		},
+		{
+			name:      "before epoch",
+			inputDate: "1900-03-01",
+			want:      -25508,
+		},
+		{
+			name:      "full timestamp keeps the date",
+			inputDate: "2006-01-02T23:04:05-05:00",
+			want:      13150,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.inputDate)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			got := dteavro.DateToAvro(onlyDate)
+			if got != tt.want {
+				t.Errorf("DateToAvro() = %v, want %v", got, tt.want)
+			}
+
+			if back := dteavro.DateFromAvro(got); back.String() != onlyDate.String() {
+				t.Errorf("DateFromAvro() = %v, want %v", back, onlyDate)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package dteavro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrNotStruct       = errors.New("value is not a struct or a pointer to a struct")
+	ErrUnsupportedType = errors.New("struct field type has no avro mapping")
+	ErrFieldNative     = errors.New("native avro value can not be assigned to struct field")
+	ErrTagOption       = errors.New("unknown avro tag option")
+)
+
+const (
+	tagName = "avro"
+
+	// TagTimeMillis is the avro tag option that stores a dte.Time field as time-millis instead of time-micros,
+	// for example `avro:"opens_at,time-millis"`.
+	TagTimeMillis = "time-millis"
+)
+
+var ( //nolint:gochecknoglobals
+	dateType = reflect.TypeFor[dte.Date]()
+	timeType = reflect.TypeFor[dte.Time]()
+)
+
+// recordField is a struct field that maps to an Avro record field.
+type recordField struct {
+	index  int
+	name   string
+	schema any
+	// branch is the union branch name of the non null type, used for pointer fields.
+	branch     string
+	nullable   bool
+	timeMillis bool
+}
+
+type logicalSchema struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+}
+
+type fieldSchema struct {
+	Name    string           `json:"name"`
+	Type    any              `json:"type"`
+	Default *json.RawMessage `json:"default,omitempty"`
+}
+
+type recordSchema struct {
+	Type   string        `json:"type"`
+	Name   string        `json:"name"`
+	Fields []fieldSchema `json:"fields"`
+}
+
+// Schema generates the Avro record schema of a struct. dte.Date fields use the date logical type and dte.Time
+// fields use time-micros, or time-millis with the TagTimeMillis tag option. Pointer fields become a union with
+// null that defaults to null. Field names come from the avro tag, falling back to the Go field name, and fields
+// tagged "-" are skipped.
+func Schema(name string, v any) (string, error) {
+	fields, err := recordFields(reflect.TypeOf(v))
+	if err != nil {
+		return "", err
+	}
+
+	record := recordSchema{Type: "record", Name: name, Fields: make([]fieldSchema, 0, len(fields))}
+
+	for _, field := range fields {
+		schema := fieldSchema{Name: field.name, Type: field.schema, Default: nil}
+
+		if field.nullable {
+			null := json.RawMessage("null")
+			schema.Type = []any{"null", field.schema}
+			schema.Default = &null
+		}
+
+		record.Fields = append(record.Fields, schema)
+	}
+
+	marshaled, err := json.Marshal(record)
+	if err != nil {
+		return "", fmt.Errorf("Schema: %w", err)
+	}
+
+	return string(marshaled), nil
+}
+
+// ToNative converts a struct to the native map accepted by both hamba/avro Marshal and goavro BinaryFromNative
+// for the schema generated by Schema. dte.Date fields become a time.Time at midnight UTC and dte.Time fields the
+// time.Duration after midnight UTC. Set pointer fields are wrapped in a single key union map.
+func ToNative(v any) (map[string]any, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	fields, err := recordFields(value.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	native := make(map[string]any, len(fields))
+
+	for _, field := range fields {
+		fieldValue := value.Field(field.index)
+
+		if field.nullable {
+			if fieldValue.IsNil() {
+				native[field.name] = nil
+
+				continue
+			}
+
+			native[field.name] = map[string]any{field.branch: toNativeValue(fieldValue.Elem())}
+
+			continue
+		}
+
+		native[field.name] = toNativeValue(fieldValue)
+	}
+
+	return native, nil
+}
+
+// FromNative fills the struct pointed to by v from a native map decoded by hamba/avro or goavro.
+// Union values may be either unwrapped or wrapped in a single key map.
+func FromNative(native map[string]any, v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return fmt.Errorf("%w: %T", ErrNotStruct, v)
+	}
+
+	value = value.Elem()
+
+	fields, err := recordFields(value.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		nativeValue, ok := native[field.name]
+		if !ok {
+			continue
+		}
+
+		if union, isUnion := nativeValue.(map[string]any); isUnion && len(union) == 1 {
+			for _, unwrapped := range union {
+				nativeValue = unwrapped
+			}
+		}
+
+		fieldValue := value.Field(field.index)
+
+		if field.nullable {
+			if nativeValue == nil {
+				fieldValue.SetZero()
+
+				continue
+			}
+
+			fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+			fieldValue = fieldValue.Elem()
+		}
+
+		err = fromNativeValue(nativeValue, fieldValue, field.timeMillis)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrFieldNative, field.name, err)
+		}
+	}
+
+	return nil
+}
+
+func toNativeValue(value reflect.Value) any {
+	switch value.Type() {
+	case dateType:
+		return dateToNative(value.Interface().(dte.Date)) //nolint:forcetypeassert
+	case timeType:
+		return timeToNative(value.Interface().(dte.Time)) //nolint:forcetypeassert
+	}
+
+	switch value.Kind() { //nolint:exhaustive
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return int32(value.Int()) //nolint:gosec
+	case reflect.Int, reflect.Int64:
+		return value.Int()
+	default:
+		return value.Interface()
+	}
+}
+
+func fromNativeValue(native any, value reflect.Value, timeMillis bool) error {
+	switch value.Type() {
+	case dateType:
+		converted, err := dateFromNative(native)
+		if err != nil {
+			return err
+		}
+
+		value.Set(reflect.ValueOf(converted))
+
+		return nil
+	case timeType:
+		converted, err := timeFromNative(native, !timeMillis)
+		if err != nil {
+			return err
+		}
+
+		value.Set(reflect.ValueOf(converted))
+
+		return nil
+	}
+
+	nativeValue := reflect.ValueOf(native)
+	if !nativeValue.IsValid() || !nativeValue.Type().ConvertibleTo(value.Type()) {
+		return fmt.Errorf("%w: %T to %s", ErrFieldNative, native, value.Type())
+	}
+
+	value.Set(nativeValue.Convert(value.Type()))
+
+	return nil
+}
+
+func recordFields(typ reflect.Type) ([]recordField, error) {
+	if typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrNotStruct, typ)
+	}
+
+	fields := make([]recordField, 0, typ.NumField())
+
+	for i := range typ.NumField() {
+		structField := typ.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(structField.Tag.Get(tagName), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+
+		field := recordField{index: i, name: name, schema: nil, branch: "", nullable: false, timeMillis: false}
+
+		switch options {
+		case "":
+		case TagTimeMillis:
+			field.timeMillis = true
+		default:
+			return nil, fmt.Errorf("%w: %s: %q", ErrTagOption, structField.Name, options)
+		}
+
+		fieldType := structField.Type
+		if fieldType.Kind() == reflect.Pointer {
+			field.nullable = true
+			fieldType = fieldType.Elem()
+		}
+
+		var err error
+
+		field.schema, field.branch, err = typeSchema(fieldType, field.timeMillis)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, structField.Name)
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// typeSchema returns the Avro schema of a Go type and its name as a union branch.
+func typeSchema(typ reflect.Type, timeMillis bool) (any, string, error) {
+	switch {
+	case typ == dateType:
+		return logicalSchema{Type: "int", LogicalType: "date"}, "int.date", nil
+	case typ == timeType && timeMillis:
+		return logicalSchema{Type: "int", LogicalType: "time-millis"}, "int.time-millis", nil
+	case typ == timeType:
+		return logicalSchema{Type: "long", LogicalType: "time-micros"}, "long.time-micros", nil
+	case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
+		return "bytes", "bytes", nil
+	}
+
+	var primitive string
+
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.String:
+		primitive = "string"
+	case reflect.Bool:
+		primitive = "boolean"
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		primitive = "int"
+	case reflect.Int, reflect.Int64:
+		primitive = "long"
+	case reflect.Float32:
+		primitive = "float"
+	case reflect.Float64:
+		primitive = "double"
+	default:
+		return nil, "", fmt.Errorf("%w: %s", ErrUnsupportedType, typ)
+	}
+
+	return primitive, primitive, nil
+}
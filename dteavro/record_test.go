@@ -0,0 +1,283 @@
+package dteavro_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hamba/avro/v2"
+	"github.com/linkedin/goavro/v2"
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteavro"
+)
+
+type Shift struct {
+	Day     dte.Date  `avro:"day"`
+	Starts  dte.Time  `avro:"starts"`
+	Ends    dte.Time  `avro:"ends,time-millis"`
+	Holiday *dte.Date `avro:"holiday"`
+	Name    string    `avro:"name"`
+	Hours   int32     `avro:"hours"`
+	Ignored string    `avro:"-"`
+}
+
+func ExampleSchema() {
+	type Payment struct {
+		DueDate dte.Date  `avro:"due_date"`
+		PaidOn  *dte.Date `avro:"paid_on"`
+		Cutoff  dte.Time  `avro:"cutoff,time-millis"`
+	}
+
+	schema, err := dteavro.Schema("Payment", Payment{})
+	if err != nil {
+		return
+	}
+
+	fmt.Println(schema)
+
+	// Output: {"type":"record","name":"Payment","fields":[{"name":"due_date","type":{"type":"int","logicalType":"date"}},{"name":"paid_on","type":["null",{"type":"int","logicalType":"date"}],"default":null},{"name":"cutoff","type":{"type":"int","logicalType":"time-millis"}}]}
+}
+
+func ExampleToNative() {
+	type Payment struct {
+		DueDate dte.Date `avro:"due_date"`
+	}
+
+	dueDate, err := dte.NewDate("2006-01-02")
+	if err != nil {
+		return
+	}
+
+	schema, err := dteavro.Schema("Payment", Payment{})
+	if err != nil {
+		return
+	}
+
+	native, err := dteavro.ToNative(Payment{DueDate: dueDate})
+	if err != nil {
+		return
+	}
+
+	encoded, err := avro.Marshal(avro.MustParse(schema), native)
+	if err != nil {
+		return
+	}
+
+	decoded := map[string]any{}
+
+	err = avro.Unmarshal(avro.MustParse(schema), encoded, &decoded)
+	if err != nil {
+		return
+	}
+
+	var payment Payment
+
+	err = dteavro.FromNative(decoded, &payment)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(payment.DueDate)
+
+	// Output: 2006-01-02
+}
+
+func newShift(t *testing.T, holiday bool) Shift {
+	t.Helper()
+
+	day, err := dte.NewDate("2024-02-29")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	starts, err := dte.NewTime("08:30:00Z")
+	if err != nil {
+		t.Fatalf("NewTime() error = %v", err)
+	}
+
+	ends, err := dte.NewTime("17:00:00-05:00")
+	if err != nil {
+		t.Fatalf("NewTime() error = %v", err)
+	}
+
+	shift := Shift{Day: day, Starts: starts, Ends: ends, Holiday: nil, Name: "early", Hours: 8, Ignored: "ignored"}
+	if holiday {
+		shift.Holiday = &day
+	}
+
+	return shift
+}
+
+func checkShift(t *testing.T, got Shift, want Shift) {
+	t.Helper()
+
+	want.Ignored = ""
+
+	if got.Holiday == nil || want.Holiday == nil {
+		if got.Holiday != want.Holiday {
+			t.Errorf("FromNative() holiday = %v, want %v", got.Holiday, want.Holiday)
+		}
+
+		got.Holiday, want.Holiday = nil, nil
+	} else if *got.Holiday != *want.Holiday {
+		t.Errorf("FromNative() holiday = %v, want %v", *got.Holiday, *want.Holiday)
+	}
+
+	got.Holiday, want.Holiday = nil, nil
+
+	if got != want {
+		t.Errorf("FromNative() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRecordHamba(t *testing.T) {
+	t.Parallel()
+
+	schemaJSON, err := dteavro.Schema("Shift", Shift{})
+	if err != nil {
+		t.Fatalf("Schema() error = %v", err)
+	}
+
+	schema, err := avro.Parse(schemaJSON)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	for _, holiday := range []bool{true, false} {
+		t.Run(fmt.Sprint("holiday ", holiday), func(t *testing.T) {
+			t.Parallel()
+
+			shift := newShift(t, holiday)
+
+			native, err := dteavro.ToNative(&shift)
+			if err != nil {
+				t.Fatalf("ToNative() error = %v", err)
+			}
+
+			encoded, err := avro.Marshal(schema, native)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			decoded := map[string]any{}
+
+			err = avro.Unmarshal(schema, encoded, &decoded)
+			if err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			var got Shift
+
+			err = dteavro.FromNative(decoded, &got)
+			if err != nil {
+				t.Fatalf("FromNative() error = %v", err)
+			}
+
+			checkShift(t, got, shift)
+		})
+	}
+}
+
+func TestRecordGoavro(t *testing.T) {
+	t.Parallel()
+
+	schemaJSON, err := dteavro.Schema("Shift", Shift{})
+	if err != nil {
+		t.Fatalf("Schema() error = %v", err)
+	}
+
+	codec, err := goavro.NewCodec(schemaJSON)
+	if err != nil {
+		t.Fatalf("NewCodec() error = %v", err)
+	}
+
+	for _, holiday := range []bool{true, false} {
+		t.Run(fmt.Sprint("holiday ", holiday), func(t *testing.T) {
+			t.Parallel()
+
+			shift := newShift(t, holiday)
+
+			native, err := dteavro.ToNative(shift)
+			if err != nil {
+				t.Fatalf("ToNative() error = %v", err)
+			}
+
+			encoded, err := codec.BinaryFromNative(nil, native)
+			if err != nil {
+				t.Fatalf("BinaryFromNative() error = %v", err)
+			}
+
+			decoded, _, err := codec.NativeFromBinary(encoded)
+			if err != nil {
+				t.Fatalf("NativeFromBinary() error = %v", err)
+			}
+
+			var got Shift
+
+			err = dteavro.FromNative(decoded.(map[string]any), &got) //nolint:forcetypeassert
+			if err != nil {
+				t.Fatalf("FromNative() error = %v", err)
+			}
+
+			checkShift(t, got, shift)
+		})
+	}
+}
+
+func TestRecordErrors(t *testing.T) {
+	t.Parallel()
+
+	type Unsupported struct {
+		Values []string `avro:"values"`
+	}
+
+	type BadOption struct {
+		Day dte.Date `avro:"day,time-nanos"`
+	}
+
+	tests := []struct {
+		name    string
+		input   any
+		wantErr error
+	}{
+		{name: "not a struct", input: 1, wantErr: dteavro.ErrNotStruct},
+		{name: "unsupported field", input: Unsupported{}, wantErr: dteavro.ErrUnsupportedType},
+		{name: "unknown tag option", input: BadOption{}, wantErr: dteavro.ErrTagOption},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dteavro.Schema("Record", tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Schema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			_, err = dteavro.ToNative(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ToNative() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	var shift Shift
+
+	err := dteavro.FromNative(map[string]any{"day": "2006-01-02"}, &shift)
+	if !errors.Is(err, dteavro.ErrFieldNative) || !errors.Is(err, dteavro.ErrDateNative) {
+		t.Errorf("FromNative() error = %v, wantErr %v", err, dteavro.ErrDateNative)
+	}
+
+	for _, days := range []any{int64(math.MaxInt32) + 1, int64(math.MinInt32) - 1} {
+		err = dteavro.FromNative(map[string]any{"day": days}, &shift)
+		if !errors.Is(err, dteavro.ErrDateNative) {
+			t.Errorf("FromNative(%v) error = %v, wantErr %v", days, err, dteavro.ErrDateNative)
+		}
+	}
+
+	err = dteavro.FromNative(map[string]any{"day": int64(1)}, &shift)
+	if err != nil || shift.Day.String() != "1970-01-02" {
+		t.Errorf("FromNative() = %v, %v, want 1970-01-02", shift.Day, err)
+	}
+}
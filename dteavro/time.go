@@ -0,0 +1,95 @@
+package dteavro
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrTimeNative     = errors.New("native avro value can not be converted to a time")
+	ErrTimeOutOfRange = errors.New("avro time value is outside of a single day")
+)
+
+const (
+	// TimeMillisSchema is the Avro schema of the time-millis logical type, an int counting milliseconds after
+	// midnight.
+	TimeMillisSchema = `{"type":"int","logicalType":"time-millis"}`
+	// TimeMicrosSchema is the Avro schema of the time-micros logical type, a long counting microseconds after
+	// midnight.
+	TimeMicrosSchema = `{"type":"long","logicalType":"time-micros"}`
+)
+
+// TimeToAvroMillis converts the time of day in UTC to the milliseconds after midnight stored by time-millis.
+func TimeToAvroMillis(t dte.Time) int32 {
+	return int32(timeToNative(t) / time.Millisecond) //nolint:gosec
+}
+
+// TimeToAvroMicros converts the time of day in UTC to the microseconds after midnight stored by time-micros.
+func TimeToAvroMicros(t dte.Time) int64 {
+	return int64(timeToNative(t) / time.Microsecond)
+}
+
+// TimeFromAvroMillis converts milliseconds after midnight to a time in UTC.
+func TimeFromAvroMillis(millis int32) (dte.Time, error) {
+	return timeFromDuration(time.Duration(millis) * time.Millisecond)
+}
+
+// TimeFromAvroMicros converts microseconds after midnight to a time in UTC.
+func TimeFromAvroMicros(micros int64) (dte.Time, error) {
+	if micros < 0 || micros >= int64(24*time.Hour/time.Microsecond) {
+		return dte.Time{}, fmt.Errorf("%w: %dµs", ErrTimeOutOfRange, micros)
+	}
+
+	return timeFromDuration(time.Duration(micros) * time.Microsecond)
+}
+
+// timeToNative returns the time of day in UTC as the time.Duration after midnight that hamba/avro and goavro
+// encode as time-millis and time-micros.
+func timeToNative(t dte.Time) time.Duration {
+	utc := t.UTC()
+	hour, minute, second := utc.Clock()
+
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute +
+		time.Duration(second)*time.Second + time.Duration(utc.Nanosecond())
+}
+
+// timeFromNative converts the value decoded by hamba/avro or goavro for a time-millis or time-micros to a time.
+// Raw integers are read as microseconds when micros is set and as milliseconds otherwise.
+func timeFromNative(native any, micros bool) (dte.Time, error) {
+	var raw int64
+
+	switch v := native.(type) {
+	case time.Duration:
+		return timeFromDuration(v)
+	case int32:
+		raw = int64(v)
+	case int64:
+		raw = v
+	case int:
+		raw = int64(v)
+	default:
+		return dte.Time{}, fmt.Errorf("%w: %T", ErrTimeNative, native)
+	}
+
+	if micros {
+		return TimeFromAvroMicros(raw)
+	}
+
+	if raw < 0 || raw >= int64(24*time.Hour/time.Millisecond) {
+		return dte.Time{}, fmt.Errorf("%w: %dms", ErrTimeOutOfRange, raw)
+	}
+
+	return TimeFromAvroMillis(int32(raw)) //nolint:gosec
+}
+
+// timeFromDuration returns the time in UTC that is the duration after midnight.
+func timeFromDuration(sinceMidnight time.Duration) (dte.Time, error) {
+	if sinceMidnight < 0 || sinceMidnight >= 24*time.Hour {
+		return dte.Time{}, fmt.Errorf("%w: %s", ErrTimeOutOfRange, sinceMidnight)
+	}
+
+	return dte.Time{Time: time.Date(0, time.January, 1, 0, 0, 0, int(sinceMidnight), time.UTC)}, nil
+}
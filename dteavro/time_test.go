@@ -0,0 +1,99 @@
+package dteavro_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteavro"
+)
+
+func ExampleTimeToAvroMicros() {
+	onlyTime, err := dte.NewTime("10:04:05-05:00")
+	if err != nil {
+		return
+	}
+
+	micros := dteavro.TimeToAvroMicros(onlyTime)
+
+	back, err := dteavro.TimeFromAvroMicros(micros)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(micros, back)
+
+	// Output: 54245000000 15:04:05Z
+}
+
+func TestTimeToAvro(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		inputTime  dte.Time
+		wantMillis int32
+		wantMicros int64
+	}{
+		{
+			name:       "midnight",
+			inputTime:  func() dte.Time { t, _ := dte.NewTime("00:00:00Z"); return t }(), //nolint:nlreturn
+			wantMillis: 0,
+			wantMicros: 0,
+		},
+		{
+			name:       "sub millisecond precision",
+			inputTime:  dte.Time{Time: time.Date(0, 1, 1, 0, 0, 1, 2_003_000, time.UTC)},
+			wantMillis: 1002,
+			wantMicros: 1_002_003,
+		},
+		{
+			name:       "offset is converted to UTC",
+			inputTime:  dte.Time{Time: time.Date(0, 1, 1, 0, 0, 0, 0, time.FixedZone("UTC+1", 3600))},
+			wantMillis: 23 * 3600 * 1000,
+			wantMicros: 23 * 3600 * 1_000_000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := dteavro.TimeToAvroMillis(tt.inputTime); got != tt.wantMillis {
+				t.Errorf("TimeToAvroMillis() = %v, want %v", got, tt.wantMillis)
+			}
+
+			if got := dteavro.TimeToAvroMicros(tt.inputTime); got != tt.wantMicros {
+				t.Errorf("TimeToAvroMicros() = %v, want %v", got, tt.wantMicros)
+			}
+
+			back, err := dteavro.TimeFromAvroMicros(tt.wantMicros)
+			if err != nil {
+				t.Fatalf("TimeFromAvroMicros() error = %v", err)
+			}
+
+			if back.String() != tt.inputTime.UTC().Format(dte.TimeOnlyWithTimezone) {
+				t.Errorf("TimeFromAvroMicros() = %v, want %v", back, tt.inputTime)
+			}
+		})
+	}
+}
+
+func TestTimeFromAvroOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	_, err := dteavro.TimeFromAvroMillis(24 * 3600 * 1000)
+	if !errors.Is(err, dteavro.ErrTimeOutOfRange) {
+		t.Errorf("TimeFromAvroMillis() error = %v, wantErr %v", err, dteavro.ErrTimeOutOfRange)
+	}
+
+	for _, micros := range []int64{-1, math.MinInt64, math.MaxInt64} {
+		_, err = dteavro.TimeFromAvroMicros(micros)
+		if !errors.Is(err, dteavro.ErrTimeOutOfRange) {
+			t.Errorf("TimeFromAvroMicros(%d) error = %v, wantErr %v", micros, err, dteavro.ErrTimeOutOfRange)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package dtecsv
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrNoLayout          = errors.New("no known layout matches every value in the column")
+	ErrAmbiguousDayMonth = errors.New("column matches both a day first and a month first layout")
+	ErrEmptyColumn       = errors.New("column has no values to infer a layout from")
+)
+
+type candidate struct {
+	layout string
+	// order is 'd' for day first layouts, 'm' for month first layouts and 0 when the order is unambiguous.
+	order byte
+}
+
+// dateCandidates are tried in order, the first layout matching every value wins unless a day first and a month
+// first layout both match.
+var dateCandidates = []candidate{ //nolint:gochecknoglobals
+	{layout: dte.DateOnly, order: 0},
+	{layout: time.RFC3339, order: 0},
+	{layout: "20060102", order: 0},
+	{layout: "1/2/2006", order: 'm'},
+	{layout: "2/1/2006", order: 'd'},
+	{layout: "1/2/06", order: 'm'},
+	{layout: "2/1/06", order: 'd'},
+	{layout: "2.1.2006", order: 'd'},
+	{layout: "2-Jan-06", order: 0},
+	{layout: "2-Jan-2006", order: 0},
+	{layout: "2 Jan 2006", order: 0},
+	{layout: "Jan 2, 2006", order: 0},
+	{layout: "January 2, 2006", order: 0},
+}
+
+// timeCandidates are tried in order. Layouts without a time zone are read as UTC.
+var timeCandidates = []candidate{ //nolint:gochecknoglobals
+	{layout: dte.TimeOnlyWithTimezone, order: 0},
+	{layout: dte.TimeOnlyWithTimezoneWithSpace, order: 0},
+	{layout: dte.TimeOnlyWithTimezoneShort, order: 0},
+	{layout: time.RFC3339, order: 0},
+	{layout: time.TimeOnly, order: 0},
+	{layout: "15:04", order: 0},
+	{layout: "3:04:05 PM", order: 0},
+	{layout: "3:04 PM", order: 0},
+}
+
+// InferDateLayout returns the single layout that parses every non empty value of a date column.
+// A column such as "01/02/2024", "03/04/2024" fits both day first and month first layouts and returns
+// ErrAmbiguousDayMonth, while a single "13/02/2024" anywhere in the column decides it.
+func InferDateLayout(values []string) (string, error) {
+	return inferLayout(values, dateCandidates)
+}
+
+// InferTimeLayout returns the single layout that parses every non empty value of a time column.
+func InferTimeLayout(values []string) (string, error) {
+	return inferLayout(values, timeCandidates)
+}
+
+// ParseDateColumn infers the layout of a date column with InferDateLayout and parses every value with it.
+// Empty values become the zero Date.
+func ParseDateColumn(values []string) ([]dte.Date, error) {
+	layout, err := InferDateLayout(values)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]dte.Date, len(values))
+
+	for i, value := range values {
+		dates[i], err = parseDate(layout, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dates, nil
+}
+
+// ParseTimeColumn infers the layout of a time column with InferTimeLayout and parses every value with it.
+// Empty values become the zero Time.
+func ParseTimeColumn(values []string) ([]dte.Time, error) {
+	layout, err := InferTimeLayout(values)
+	if err != nil {
+		return nil, err
+	}
+
+	times := make([]dte.Time, len(values))
+
+	for i, value := range values {
+		times[i], err = parseTime(layout, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return times, nil
+}
+
+func inferLayout(values []string, candidates []candidate) (string, error) {
+	remaining := make([]candidate, len(candidates))
+	copy(remaining, candidates)
+
+	seen := false
+
+	for _, value := range values {
+		value = normalise(value)
+		if value == "" {
+			continue
+		}
+
+		seen = true
+		matching := remaining[:0]
+
+		for _, c := range remaining {
+			if _, err := time.Parse(c.layout, value); err == nil {
+				matching = append(matching, c)
+			}
+		}
+
+		if len(matching) == 0 {
+			return "", fmt.Errorf("%w: %q", ErrNoLayout, value)
+		}
+
+		remaining = matching
+	}
+
+	if !seen {
+		return "", ErrEmptyColumn
+	}
+
+	for _, c := range remaining[1:] {
+		if c.order != 0 && remaining[0].order != 0 && c.order != remaining[0].order {
+			return "", fmt.Errorf("%w: %q or %q", ErrAmbiguousDayMonth, remaining[0].layout, c.layout)
+		}
+	}
+
+	return remaining[0].layout, nil
+}
+
+// normalise trims a value and upper cases it, so "pm" matches the PM layout element. Month names already match
+// case insensitively.
+func normalise(value string) string {
+	return strings.ToUpper(strings.TrimSpace(value))
+}
+
+// parseDate parses a single value of a column with a known layout. Empty values become the zero Date.
+func parseDate(layout string, value string) (dte.Date, error) {
+	value = normalise(value)
+	if value == "" {
+		return dte.Date{}, nil
+	}
+
+	parsed, err := time.Parse(layout, value)
+	if err != nil {
+		return dte.Date{}, fmt.Errorf("%w: %w", dte.ErrDateParse, err)
+	}
+
+	var onlyDate dte.Date
+
+	err = onlyDate.SetFromTime(parsed)
+	if err != nil {
+		return dte.Date{}, err //nolint:wrapcheck
+	}
+
+	return onlyDate, nil
+}
+
+// parseTime parses a single value of a column with a known layout. Empty values become the zero Time.
+func parseTime(layout string, value string) (dte.Time, error) {
+	value = normalise(value)
+	if value == "" {
+		return dte.Time{}, nil
+	}
+
+	parsed, err := time.Parse(layout, value)
+	if err != nil {
+		return dte.Time{}, fmt.Errorf("%w: %w", dte.ErrTimeParse, err)
+	}
+
+	var onlyTime dte.Time
+
+	err = onlyTime.SetFromTime(parsed)
+	if err != nil {
+		return dte.Time{}, err //nolint:wrapcheck
+	}
+
+	return onlyTime, nil
+}
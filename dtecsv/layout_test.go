@@ -0,0 +1,133 @@
+package dtecsv_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtecsv"
+)
+
+func ExampleInferDateLayout() {
+	layout, err := dtecsv.InferDateLayout([]string{"01/02/2024", "13/02/2024", ""})
+	if err != nil {
+		return
+	}
+
+	fmt.Println(layout)
+
+	_, err = dtecsv.InferDateLayout([]string{"01/02/2024", "03/04/2024"})
+	fmt.Println(errors.Is(err, dtecsv.ErrAmbiguousDayMonth))
+
+	// Output:
+	// 2/1/2006
+	// true
+}
+
+func ExampleParseDateColumn() {
+	dates, err := dtecsv.ParseDateColumn([]string{"2-Jan-24", "29-Feb-24"})
+	if err != nil {
+		return
+	}
+
+	fmt.Println(dates)
+
+	// Output: [2024-01-02 2024-02-29]
+}
+
+func TestInferDateLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		values  []string
+		want    string
+		wantErr error
+	}{
+		{name: "iso", values: []string{"2024-01-02", "2024-12-31"}, want: "2006-01-02", wantErr: nil},
+		{name: "rfc3339", values: []string{"2024-01-02T10:00:00Z"}, want: "2006-01-02T15:04:05Z07:00", wantErr: nil},
+		{name: "compact", values: []string{"20240102"}, want: "20060102", wantErr: nil},
+		{name: "month first", values: []string{"01/02/2024", "12/31/2024"}, want: "1/2/2006", wantErr: nil},
+		{name: "day first", values: []string{"1/2/2024", "31/12/2024"}, want: "2/1/2006", wantErr: nil},
+		{name: "short year month first", values: []string{"1/2/24", "2/13/24"}, want: "1/2/06", wantErr: nil},
+		{name: "dotted", values: []string{"02.01.2024"}, want: "2.1.2006", wantErr: nil},
+		{name: "month name", values: []string{"2-Jan-24", "15-mar-24"}, want: "2-Jan-06", wantErr: nil},
+		{name: "long month name", values: []string{"January 2, 2024"}, want: "January 2, 2006", wantErr: nil},
+		{name: "surrounding space", values: []string{" 2024-01-02 "}, want: "2006-01-02", wantErr: nil},
+		{
+			name:    "ambiguous",
+			values:  []string{"01/02/2024", "05/06/2024"},
+			want:    "",
+			wantErr: dtecsv.ErrAmbiguousDayMonth,
+		},
+		{name: "mixed layouts", values: []string{"2024-01-02", "01/02/2024"}, want: "", wantErr: dtecsv.ErrNoLayout},
+		{name: "not a date", values: []string{"tomorrow"}, want: "", wantErr: dtecsv.ErrNoLayout},
+		{name: "conflicting rows", values: []string{"13/01/2024", "01/13/2024"}, want: "", wantErr: dtecsv.ErrNoLayout},
+		{name: "empty", values: []string{"", " "}, want: "", wantErr: dtecsv.ErrEmptyColumn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dtecsv.InferDateLayout(tt.values)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("InferDateLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("InferDateLayout() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInferTimeLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		values  []string
+		want    string
+		wantErr error
+	}{
+		{name: "with time zone", values: []string{"15:04:05Z", "10:00:00-05:00"}, want: "15:04:05Z07:00", wantErr: nil},
+		{name: "without time zone", values: []string{"15:04:05"}, want: "15:04:05", wantErr: nil},
+		{name: "minutes", values: []string{"9:30", "17:45"}, want: "15:04", wantErr: nil},
+		{name: "twelve hour clock", values: []string{"9:30 AM", "5:45 pm"}, want: "3:04 PM", wantErr: nil},
+		{name: "not a time", values: []string{"noon"}, want: "", wantErr: dtecsv.ErrNoLayout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dtecsv.InferTimeLayout(tt.values)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("InferTimeLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("InferTimeLayout() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTimeColumn(t *testing.T) {
+	t.Parallel()
+
+	times, err := dtecsv.ParseTimeColumn([]string{"9:30 AM", "", "5:45 PM"})
+	if err != nil {
+		t.Fatalf("ParseTimeColumn() error = %v", err)
+	}
+
+	got := fmt.Sprint(times[0], " ", times[2])
+	if want := "09:30:00Z 17:45:00Z"; got != want {
+		t.Errorf("ParseTimeColumn() = %q, want %q", got, want)
+	}
+
+	if !times[1].IsZero() {
+		t.Errorf("ParseTimeColumn() empty value = %v, want zero", times[1])
+	}
+}
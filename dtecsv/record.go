@@ -0,0 +1,451 @@
+package dtecsv
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrNotSlice        = errors.New("value is not a slice of structs or a pointer to one")
+	ErrUnsupportedType = errors.New("struct field type has no csv mapping")
+	ErrTagOption       = errors.New("unknown csv tag option")
+	ErrField           = errors.New("csv value can not be converted")
+)
+
+const (
+	tagName = "csv"
+
+	// TagLayout is the csv tag option that fixes the layout of a dte.Date or dte.Time column instead of inferring
+	// it, for example `csv:"due,layout=02/01/2006"`. It must be the last option since layouts may contain commas.
+	TagLayout = "layout="
+)
+
+var ( //nolint:gochecknoglobals
+	dateType            = reflect.TypeFor[dte.Date]()
+	timeType            = reflect.TypeFor[dte.Time]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// column is a struct field that maps to a CSV column.
+type column struct {
+	index  int
+	name   string
+	layout string
+}
+
+// Marshal converts a slice of structs to CSV records, starting with a header row. dte.Date and dte.Time fields are
+// written in their String format, or with the layout of the TagLayout tag option. Column names come from the csv tag,
+// falling back to the Go field name, and fields tagged "-" are skipped. Nil pointer fields are written empty.
+func Marshal(v any) ([][]string, error) {
+	slice := reflect.ValueOf(v)
+	if slice.Kind() == reflect.Pointer {
+		slice = slice.Elem()
+	}
+
+	if slice.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%w: %T", ErrNotSlice, v)
+	}
+
+	columns, err := structColumns(slice.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([][]string, 0, slice.Len()+1)
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.name
+	}
+
+	records = append(records, header)
+
+	for row := range slice.Len() {
+		value := slice.Index(row)
+		if value.Kind() == reflect.Pointer {
+			value = value.Elem()
+		}
+
+		record := make([]string, len(columns))
+
+		for i, col := range columns {
+			record[i], err = formatValue(value.Field(col.index), col.layout)
+			if err != nil {
+				return nil, fmt.Errorf("%w: row %d column %q: %w", ErrField, row+1, col.name, err)
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// Unmarshal fills the slice pointed to by v from CSV records whose first row is the header. Columns are matched to
+// fields by name and columns without a field are ignored. The layout of every dte.Date and dte.Time column without
+// the TagLayout tag option is inferred from the whole column with InferDateLayout or InferTimeLayout, so one
+// unambiguous row decides between day first and month first for the rest. Empty values leave the field at its zero
+// value, or nil for pointer fields.
+func Unmarshal(records [][]string, v any) error {
+	slice := reflect.ValueOf(v)
+	if slice.Kind() != reflect.Pointer || slice.IsNil() || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("%w: %T", ErrNotSlice, v)
+	}
+
+	slice = slice.Elem()
+	elemType := slice.Type().Elem()
+
+	structType := elemType
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	columns, err := structColumns(structType)
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		slice.SetLen(0)
+
+		return nil
+	}
+
+	positions := headerPositions(records[0], columns)
+
+	err = inferLayouts(records[1:], columns, positions, structType)
+	if err != nil {
+		return err
+	}
+
+	result := reflect.MakeSlice(slice.Type(), len(records)-1, len(records)-1)
+
+	for row, record := range records[1:] {
+		value := result.Index(row)
+		if elemType.Kind() == reflect.Pointer {
+			value.Set(reflect.New(structType))
+			value = value.Elem()
+		}
+
+		for i, col := range columns {
+			if positions[i] < 0 || positions[i] >= len(record) {
+				continue
+			}
+
+			err = parseValue(record[positions[i]], value.Field(col.index), col.layout)
+			if err != nil {
+				return fmt.Errorf("%w: row %d column %q: %w", ErrField, row+1, col.name, err)
+			}
+		}
+	}
+
+	slice.Set(result)
+
+	return nil
+}
+
+// Write marshals v with Marshal and writes the records to w with encoding/csv.
+func Write(w io.Writer, v any) error {
+	records, err := Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	err = csv.NewWriter(w).WriteAll(records)
+	if err != nil {
+		return fmt.Errorf("Write: %w", err)
+	}
+
+	return nil
+}
+
+// Read reads all records from r with encoding/csv and unmarshals them into v with Unmarshal.
+func Read(r io.Reader, v any) error {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return fmt.Errorf("Read: %w", err)
+	}
+
+	return Unmarshal(records, v)
+}
+
+// headerPositions returns the record index of every column, or -1 when the header has no such column.
+func headerPositions(header []string, columns []column) []int {
+	positions := make([]int, len(columns))
+
+	for i, col := range columns {
+		positions[i] = -1
+
+		for position, name := range header {
+			if strings.TrimSpace(name) == col.name {
+				positions[i] = position
+
+				break
+			}
+		}
+	}
+
+	return positions
+}
+
+// inferLayouts sets the layout of every dte.Date and dte.Time column that has none from its values.
+func inferLayouts(records [][]string, columns []column, positions []int, structType reflect.Type) error {
+	for i := range columns {
+		col := &columns[i]
+		if col.layout != "" || positions[i] < 0 {
+			continue
+		}
+
+		fieldType := indirectType(structType.Field(col.index).Type)
+		if fieldType != dateType && fieldType != timeType {
+			continue
+		}
+
+		values := make([]string, 0, len(records))
+
+		for _, record := range records {
+			if positions[i] < len(record) {
+				values = append(values, record[positions[i]])
+			}
+		}
+
+		var err error
+
+		if fieldType == dateType {
+			col.layout, err = InferDateLayout(values)
+		} else {
+			col.layout, err = InferTimeLayout(values)
+		}
+
+		if errors.Is(err, ErrEmptyColumn) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("column %q: %w", col.name, err)
+		}
+	}
+
+	return nil
+}
+
+func formatValue(value reflect.Value, layout string) (string, error) {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", nil
+		}
+
+		value = value.Elem()
+	}
+
+	switch value.Type() {
+	case dateType:
+		onlyDate := value.Interface().(dte.Date) //nolint:forcetypeassert
+		if layout == "" {
+			return onlyDate.String(), nil
+		}
+
+		return onlyDate.Format(layout), nil
+	case timeType:
+		onlyTime := value.Interface().(dte.Time) //nolint:forcetypeassert
+		if layout == "" {
+			return onlyTime.String(), nil
+		}
+
+		return onlyTime.Format(layout), nil
+	}
+
+	if value.Type().Implements(textMarshalerType) {
+		text, err := value.Interface().(encoding.TextMarshaler).MarshalText() //nolint:forcetypeassert
+		if err != nil {
+			return "", err //nolint:wrapcheck
+		}
+
+		return string(text), nil
+	}
+
+	switch value.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, value.Type())
+	}
+}
+
+func parseValue(text string, value reflect.Value, layout string) error {
+	if value.Kind() == reflect.Pointer {
+		if strings.TrimSpace(text) == "" {
+			value.SetZero()
+
+			return nil
+		}
+
+		value.Set(reflect.New(value.Type().Elem()))
+		value = value.Elem()
+	}
+
+	switch value.Type() {
+	case dateType:
+		onlyDate, err := parseDate(layout, text)
+		if err != nil {
+			return err
+		}
+
+		value.Set(reflect.ValueOf(onlyDate))
+
+		return nil
+	case timeType:
+		onlyTime, err := parseTime(layout, text)
+		if err != nil {
+			return err
+		}
+
+		value.Set(reflect.ValueOf(onlyTime))
+
+		return nil
+	}
+
+	if reflect.PointerTo(value.Type()).Implements(textUnmarshalerType) {
+		unmarshaler := value.Addr().Interface().(encoding.TextUnmarshaler) //nolint:forcetypeassert
+
+		return unmarshaler.UnmarshalText([]byte(text)) //nolint:wrapcheck
+	}
+
+	if value.Kind() != reflect.String {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			value.SetZero()
+
+			return nil
+		}
+	}
+
+	return parseBasic(text, value)
+}
+
+func parseBasic(text string, value reflect.Value) error {
+	switch value.Kind() { //nolint:exhaustive
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(text, value.Type().Bits())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		value.SetFloat(parsed)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, value.Type())
+	}
+
+	return nil
+}
+
+func structColumns(typ reflect.Type) ([]column, error) {
+	typ = indirectType(typ)
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrNotSlice, typ)
+	}
+
+	columns := make([]column, 0, typ.NumField())
+
+	for i := range typ.NumField() {
+		structField := typ.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(structField.Tag.Get(tagName), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+
+		col := column{index: i, name: name, layout: ""}
+
+		switch {
+		case options == "":
+		case strings.HasPrefix(options, TagLayout):
+			col.layout = strings.TrimPrefix(options, TagLayout)
+		default:
+			return nil, fmt.Errorf("%w: %s: %q", ErrTagOption, structField.Name, options)
+		}
+
+		err := checkType(structField.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, structField.Name)
+		}
+
+		columns = append(columns, col)
+	}
+
+	return columns, nil
+}
+
+func checkType(typ reflect.Type) error {
+	typ = indirectType(typ)
+
+	if typ == dateType || typ == timeType ||
+		typ.Implements(textMarshalerType) && reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		return nil
+	}
+
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, typ)
+	}
+}
+
+func indirectType(typ reflect.Type) reflect.Type {
+	if typ.Kind() == reflect.Pointer {
+		return typ.Elem()
+	}
+
+	return typ
+}
@@ -0,0 +1,170 @@
+package dtecsv_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtecsv"
+)
+
+type Invoice struct {
+	Number  int       `csv:"number"`
+	Issued  dte.Date  `csv:"issued"`
+	Due     *dte.Date `csv:"due"`
+	Cutoff  dte.Time  `csv:"cutoff,layout=15:04"`
+	Amount  float64   `csv:"amount"`
+	Paid    bool      `csv:"paid"`
+	Note    string
+	Ignored string `csv:"-"`
+}
+
+func ExampleWrite() {
+	issued, err := dte.NewDate("2024-01-02")
+	if err != nil {
+		return
+	}
+
+	cutoff, err := dte.NewTime("17:30:00Z")
+	if err != nil {
+		return
+	}
+
+	invoices := []Invoice{{Number: 1, Issued: issued, Due: nil, Cutoff: cutoff, Amount: 9.5, Paid: true}}
+
+	err = dtecsv.Write(os.Stdout, invoices)
+	if err != nil {
+		return
+	}
+
+	// Output:
+	// number,issued,due,cutoff,amount,paid,Note
+	// 1,2024-01-02,,17:30,9.5,true,
+}
+
+func ExampleRead() {
+	input := "number,issued,due\n1,01/02/2024,\n2,13/02/2024,14/03/2024\n"
+
+	var invoices []Invoice
+
+	err := dtecsv.Read(strings.NewReader(input), &invoices)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(invoices[0].Issued, invoices[0].Due, invoices[1].Issued, *invoices[1].Due)
+
+	// Output: 2024-02-01 <nil> 2024-02-13 2024-03-14
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	issued, err := dte.NewDate("2024-02-29")
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	cutoff, err := dte.NewTime("08:15:00Z")
+	if err != nil {
+		t.Fatalf("NewTime() error = %v", err)
+	}
+
+	want := []*Invoice{
+		{Number: 1, Issued: issued, Due: &issued, Cutoff: cutoff, Amount: 1.25, Paid: false, Note: "a, b", Ignored: ""},
+		{Number: 2, Issued: issued, Due: nil, Cutoff: cutoff, Amount: 0, Paid: true, Note: "", Ignored: ""},
+	}
+
+	var builder strings.Builder
+
+	err = dtecsv.Write(&builder, want)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	var got []*Invoice
+
+	err = dtecsv.Read(strings.NewReader(builder.String()), &got)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Read() = %d records, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		gotCopy, wantCopy := *got[i], *want[i]
+
+		if (gotCopy.Due == nil) != (wantCopy.Due == nil) || gotCopy.Due != nil && *gotCopy.Due != *wantCopy.Due {
+			t.Errorf("Read() due = %v, want %v", gotCopy.Due, wantCopy.Due)
+		}
+
+		gotCopy.Due, wantCopy.Due = nil, nil
+
+		if gotCopy != wantCopy {
+			t.Errorf("Read() = %+v, want %+v", gotCopy, wantCopy)
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+
+	type Unsupported struct {
+		Values []string `csv:"values"`
+	}
+
+	type BadOption struct {
+		Day dte.Date `csv:"day,omitempty"`
+	}
+
+	tests := []struct {
+		name    string
+		records [][]string
+		target  any
+		wantErr error
+	}{
+		{name: "not a pointer", records: nil, target: []Invoice{}, wantErr: dtecsv.ErrNotSlice},
+		{name: "not a slice of structs", records: nil, target: &[]int{}, wantErr: dtecsv.ErrNotSlice},
+		{name: "unsupported field", records: nil, target: &[]Unsupported{}, wantErr: dtecsv.ErrUnsupportedType},
+		{name: "unknown tag option", records: nil, target: &[]BadOption{}, wantErr: dtecsv.ErrTagOption},
+		{
+			name:    "ambiguous column",
+			records: [][]string{{"issued"}, {"01/02/2024"}},
+			target:  &[]Invoice{},
+			wantErr: dtecsv.ErrAmbiguousDayMonth,
+		},
+		{
+			name:    "bad number",
+			records: [][]string{{"number"}, {"one"}},
+			target:  &[]Invoice{},
+			wantErr: dtecsv.ErrField,
+		},
+		{
+			name:    "value not matching the tag layout",
+			records: [][]string{{"cutoff"}, {"17:30:00"}},
+			target:  &[]Invoice{},
+			wantErr: dte.ErrTimeParse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := dtecsv.Unmarshal(tt.records, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	_, err := dtecsv.Marshal(1)
+	if !errors.Is(err, dtecsv.ErrNotSlice) {
+		t.Errorf("Marshal() error = %v, wantErr %v", err, dtecsv.ErrNotSlice)
+	}
+}
@@ -32,6 +32,12 @@ func NewDate(s string) (Date, error) {
 	return timeInstance, nil
 }
 
+// Today returns the current date of the clock in the location, for defaulting date columns such as in a BeforeCreate
+// hook. Passing a dte.FakeClock makes the default the same whenever the tests run.
+func Today(clock dte.Clock, location *time.Location) Date {
+	return Date{Date: clock.Today(location)}
+}
+
 // GormDataType returns gorm common data type. This type is used for the field's column type.
 func (Date) GormDataType() string {
 	return "date"
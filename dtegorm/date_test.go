@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
 	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
 )
 
@@ -95,3 +97,22 @@ func TestDate(t *testing.T) {
 		t.Errorf("Date is not correct, %s, %s", exampleResult.OnlyDate.String(), "2006-01-02")
 	}
 }
+
+func TestToday(t *testing.T) {
+	t.Parallel()
+
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	clock := dte.NewFakeClock(time.Date(2024, time.March, 1, 3, 0, 0, 0, time.UTC))
+
+	if got := dtegorm.Today(clock, losAngeles); got.String() != "2024-02-29" {
+		t.Errorf("Today() = %s, want %s", got, "2024-02-29")
+	}
+
+	if got, err := dtegorm.Today(clock, time.UTC).Value(); err != nil || got != "2024-03-01" {
+		t.Errorf("Today().Value() = %v, %v, want %s", got, err, "2024-03-01")
+	}
+}
@@ -0,0 +1,242 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// intervalDay is the length of a day of a postgres interval.
+const intervalDay = 24 * time.Hour
+
+var (
+	ErrNewDuration             = errors.New("failed to create new duration")
+	ErrDurationScan            = errors.New("failed to scan value into duration struct")
+	ErrDurationScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// Duration stores a dte.Duration as an INTERVAL on Postgres and as text on other databases, written as a clock of
+// hours, minutes and seconds such as 26:00:00.5 that Postgres reads without a day or month part. Postgres keeps
+// microseconds, so nanoseconds are rounded. Intervals with months do not scan into a Duration since a month has no
+// fixed length, days are taken as 24 hours.
+type Duration struct { //nolint:recvcheck
+	dte.Duration `example:"PT1H30M" format:"duration"`
+}
+
+// DurationInt stores a dte.Duration as a BIGINT of nanoseconds, the value of its time.Duration.
+type DurationInt struct { //nolint:recvcheck
+	dte.Duration `example:"PT1H30M" format:"duration"`
+}
+
+func NewDuration(s string) (Duration, error) {
+	duration, err := dte.NewDuration(s)
+	if err != nil {
+		return Duration{}, fmt.Errorf("%w: %w", ErrNewDuration, err)
+	}
+
+	return Duration{duration}, nil
+}
+
+func NewDurationInt(s string) (DurationInt, error) {
+	duration, err := dte.NewDuration(s)
+	if err != nil {
+		return DurationInt{}, fmt.Errorf("%w: %w", ErrNewDuration, err)
+	}
+
+	return DurationInt{duration}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (Duration) GormDataType() string {
+	return "interval"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (Duration) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "mysql":
+		return "VARCHAR(64)"
+	case "postgres":
+		return "INTERVAL"
+	case "sqlserver":
+		return "VARCHAR(64)"
+	case "sqlite":
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into Duration. It accepts the default postgres interval
+// output, such as "1 day 02:30:00", and the ISO 8601 and Go forms of dte.NewDuration, and NULL scans into a zero
+// duration. The Format of d is kept.
+func (d *Duration) Scan(src interface{}) error {
+	var text string
+
+	switch v := src.(type) {
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	case nil:
+		d.Duration.Duration = 0
+
+		return nil
+	default:
+		return ErrDurationScanInvalidType
+	}
+
+	duration, err := parsePostgresDuration(text)
+	if err != nil {
+		parsed, parseErr := dte.NewDuration(text)
+		if parseErr != nil {
+			return err
+		}
+
+		duration = parsed.Duration
+	}
+
+	d.Duration.Duration = duration
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the duration as a clock such as -01:30:00.5.
+func (d Duration) Value() (driver.Value, error) {
+	duration := d.Duration.Duration
+
+	sign := ""
+	if duration < 0 {
+		sign = "-"
+	}
+
+	// The magnitude of math.MinInt64 does not fit a time.Duration, so its components are taken from its negative.
+	if duration > 0 {
+		duration = -duration
+	}
+
+	clock := fmt.Sprintf("%s%02d:%02d:%02d", sign, -(duration / time.Hour), -(duration % time.Hour / time.Minute),
+		-(duration % time.Minute / time.Second))
+
+	if fraction := -(duration % time.Second); fraction != 0 {
+		clock += strings.TrimRight(fmt.Sprintf(".%09d", fraction), "0")
+	}
+
+	return clock, nil
+}
+
+// parsePostgresDuration parses the postgres interval output style, day and unit pairs followed by an optional
+// clock.
+func parsePostgresDuration(s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%w: %q", ErrDurationScan, s)
+	}
+
+	var total time.Duration
+
+	if clock := fields[len(fields)-1]; strings.Contains(clock, ":") {
+		fields = fields[:len(fields)-1]
+
+		sign := ""
+		if strings.HasPrefix(clock, "-") {
+			sign = "-"
+		}
+
+		parts := strings.Split(strings.TrimLeft(clock, "+-"), ":")
+		if len(parts) != 3 { //nolint:mnd
+			return 0, fmt.Errorf("%w: %q is not a hh:mm:ss clock", ErrDurationScan, s)
+		}
+
+		duration, err := time.ParseDuration(sign + parts[0] + "h" + parts[1] + "m" + parts[2] + "s")
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q: %w", ErrDurationScan, s, err)
+		}
+
+		total = duration
+	}
+
+	if len(fields)%2 != 0 {
+		return 0, fmt.Errorf("%w: %q", ErrDurationScan, s)
+	}
+
+	for i := 0; i < len(fields); i += 2 {
+		if strings.TrimSuffix(fields[i+1], "s") != "day" {
+			return 0, fmt.Errorf("%w: %q has unit %q, which has no fixed length", ErrDurationScan, s, fields[i+1])
+		}
+
+		days, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil || days > math.MaxInt64/int64(intervalDay) || days < math.MinInt64/int64(intervalDay) {
+			return 0, fmt.Errorf("%w: %q has %q days", ErrDurationScan, s, fields[i])
+		}
+
+		total += time.Duration(days) * intervalDay
+	}
+
+	return total, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (DurationInt) GormDataType() string {
+	return "int"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (DurationInt) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	const bigint = "BIGINT"
+
+	switch db.Dialector.Name() {
+	case "mysql":
+		return bigint
+	case "postgres":
+		return bigint
+	case "sqlserver":
+		return bigint
+	case "sqlite":
+		return "INTEGER"
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into DurationInt, and NULL scans into a zero duration. The
+// Format of d is kept.
+func (d *DurationInt) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case int64:
+		d.Duration.Duration = time.Duration(v)
+	case []byte:
+		nanoseconds, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrDurationScan, err)
+		}
+
+		d.Duration.Duration = time.Duration(nanoseconds)
+	case string:
+		nanoseconds, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrDurationScan, err)
+		}
+
+		d.Duration.Duration = time.Duration(nanoseconds)
+	case nil:
+		d.Duration.Duration = 0
+	default:
+		return ErrDurationScanInvalidType
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the nanoseconds of DurationInt.
+func (d DurationInt) Value() (driver.Value, error) {
+	return int64(d.Duration.Duration), nil
+}
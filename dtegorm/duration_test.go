@@ -0,0 +1,172 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type DurationExample struct {
+	ID      uint `gorm:"primarykey"`
+	Timeout dtegorm.Duration
+	Backoff dtegorm.DurationInt
+}
+
+func ExampleDuration() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type DurationExample struct {
+		ID      uint `gorm:"primarykey"`
+		Timeout dtegorm.Duration
+	}
+
+	timeout, err := dtegorm.NewDuration("PT1H30M")
+	if err != nil {
+		return
+	}
+
+	example := DurationExample{Timeout: timeout}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult DurationExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Timeout.String())
+
+	// Output: PT1H30M
+}
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	timeout, err := dtegorm.NewDuration("-P1DT2H0.5S")
+	if err != nil {
+		t.Errorf("Error creating duration")
+	}
+
+	backoff, err := dtegorm.NewDurationInt("1.5s")
+	if err != nil {
+		t.Errorf("Error creating duration")
+	}
+
+	example := DurationExample{Timeout: timeout, Backoff: backoff}
+
+	dbResult := db.Create(&example)
+	if dbResult.Error != nil {
+		t.Errorf("Error creating example")
+	}
+
+	var exampleResult DurationExample
+
+	dbResult = db.Where("timeout < ?", "-26:00:00").First(&exampleResult, example.ID)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting example")
+	}
+
+	if exampleResult.Timeout.String() != "-PT26H0.5S" || exampleResult.Backoff.Duration.Duration != 1500*time.Millisecond {
+		t.Errorf("Durations are not correct, %s, %s", exampleResult.Timeout.String(), exampleResult.Backoff.String())
+	}
+}
+
+func TestDurationScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr error
+	}{
+		{input: "01:30:00", want: 90 * time.Minute},
+		{input: "-00:00:01.5", want: -1500 * time.Millisecond},
+		{input: "1 day 02:00:00", want: 26 * time.Hour},
+		{input: "-2 days +01:00:00", want: -47 * time.Hour},
+		{input: "3 days", want: 72 * time.Hour},
+		{input: "PT1H", want: time.Hour},
+		{input: "1 mon 00:00:00", wantErr: dtegorm.ErrDurationScan},
+		{input: "1:30", wantErr: dtegorm.ErrDurationScan},
+		{input: "", wantErr: dtegorm.ErrDurationScan},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			var duration dtegorm.Duration
+
+			err := duration.Scan(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if duration.Duration.Duration != tt.want {
+				t.Errorf("Scan() = %v, want %v", duration.Duration.Duration, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	t.Parallel()
+
+	for duration, want := range map[time.Duration]string{
+		0:                               "00:00:00",
+		90 * time.Minute:                "01:30:00",
+		-(26*time.Hour + time.Second/2): "-26:00:00.5",
+		math.MinInt64:                   "-2562047:47:16.854775808",
+	} {
+		example := dtegorm.Duration{}
+		example.Duration.Duration = duration
+
+		value, err := example.Value()
+		if err != nil || value != want {
+			t.Errorf("Value() = %v, %v, want %v", value, err, want)
+		}
+
+		var scanned dtegorm.Duration
+
+		err = scanned.Scan(value)
+		if err != nil || scanned.Duration.Duration != duration {
+			t.Errorf("Scan(Value()) = %v, %v, want %v", scanned.Duration.Duration, err, duration)
+		}
+	}
+
+	var backoff dtegorm.DurationInt
+
+	err := backoff.Scan(int64(time.Second))
+	if err != nil || backoff.Duration.Duration != time.Second {
+		t.Errorf("Scan() = %v, %v, want 1s", backoff, err)
+	}
+
+	err = backoff.Scan(nil)
+	if err != nil || backoff.Duration.Duration != 0 {
+		t.Errorf("Scan() = %v, %v, want zero", backoff, err)
+	}
+
+	timeout := dtegorm.Duration{}
+	timeout.Duration.Duration = time.Hour
+
+	err = timeout.Scan(nil)
+	if err != nil || timeout.Duration.Duration != 0 {
+		t.Errorf("Scan() = %v, %v, want zero", timeout, err)
+	}
+}
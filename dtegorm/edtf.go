@@ -0,0 +1,84 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewEDTF             = errors.New("failed to create new edtf")
+	ErrEDTFScan            = errors.New("failed to scan value into edtf struct")
+	ErrEDTFScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// EDTF stores a dte.EDTF in its canonical string form, so qualifiers, unspecified digits, intervals and sets all
+// survive the round trip. A zero EDTF is stored as NULL.
+type EDTF struct { //nolint:recvcheck
+	dte.EDTF `example:"1984?" format:"edtf"`
+}
+
+func NewEDTF(s string) (EDTF, error) {
+	parsed, err := dte.ParseEDTF(s)
+	if err != nil {
+		return EDTF{}, fmt.Errorf("%w: %w", ErrNewEDTF, err)
+	}
+
+	return EDTF{parsed}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (EDTF) GormDataType() string {
+	return "string"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (EDTF) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "mysql":
+		return "TEXT"
+	case "postgres":
+		return "TEXT"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	case "sqlite":
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into EDTF. NULL scans into the zero EDTF.
+func (e *EDTF) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		err := e.UnmarshalText(v)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrEDTFScan, err)
+		}
+	case string:
+		err := e.UnmarshalText([]byte(v))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrEDTFScan, err)
+		}
+	case nil:
+		e.EDTF = dte.EDTF{}
+	default:
+		return ErrEDTFScanInvalidType
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns string format of EDTF, or nil when it is zero.
+func (e EDTF) Value() (driver.Value, error) {
+	if e.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return e.String(), nil
+}
@@ -0,0 +1,112 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type EDTFExample struct {
+	ID       uint `gorm:"primarykey"`
+	Created  dtegorm.EDTF
+	Optional dtegorm.EDTF
+}
+
+func ExampleEDTF() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type EDTFExample struct {
+		ID      uint `gorm:"primarykey"`
+		Created dtegorm.EDTF
+	}
+
+	created, err := dtegorm.NewEDTF("2004-06~/2006-XX")
+	if err != nil {
+		return
+	}
+
+	example := EDTFExample{Created: created}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult EDTFExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Created.String())
+
+	// Output: 2004-06~/2006-XX
+}
+
+func TestEDTF(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	type Result struct {
+		ColumnName string
+		DataType   string
+	}
+
+	result := Result{}
+
+	db.Raw(
+		"SELECT column_name, data_type " +
+			"FROM information_schema.columns " +
+			"WHERE table_name = 'edtf_examples' AND column_name = 'created'",
+	).Scan(&result)
+
+	if result.ColumnName != "created" || result.DataType != "text" {
+		t.Errorf("Column name or data type is not correct, %s, %s", result.ColumnName, result.DataType)
+	}
+
+	created, err := dtegorm.NewEDTF("[1667,1668,1670..1672]")
+	if err != nil {
+		t.Errorf("Error creating edtf")
+	}
+
+	example := EDTFExample{Created: created}
+
+	dbResult := db.Create(&example)
+	if dbResult.Error != nil {
+		t.Errorf("Error creating example")
+	}
+
+	var exampleResult EDTFExample
+
+	dbResult = db.First(&exampleResult, example.ID)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting example")
+	}
+
+	if exampleResult.Created.String() != "[1667,1668,1670..1672]" {
+		t.Errorf("EDTF is not correct, %s, %s", exampleResult.Created.String(), "[1667,1668,1670..1672]")
+	}
+
+	if !exampleResult.Optional.IsZero() {
+		t.Errorf("EDTF is not zero, %s", exampleResult.Optional.String())
+	}
+}
+
+func TestNewEDTFError(t *testing.T) {
+	t.Parallel()
+
+	_, err := dtegorm.NewEDTF("1960/1950")
+	if !errors.Is(err, dtegorm.ErrNewEDTF) {
+		t.Errorf("NewEDTF() error = %v, wantErr %v", err, dtegorm.ErrNewEDTF)
+	}
+}
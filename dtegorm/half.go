@@ -0,0 +1,97 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewHalf             = errors.New("failed to create new half year")
+	ErrHalfScan            = errors.New("failed to scan value into half year struct")
+	ErrHalfScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// Half stores a dte.Half as a DATE on the first day of the half year. The date is the same for any fiscal calendar,
+// but scanning returns the calendar half year it starts, so a fiscal half year is read back with
+// FiscalCalendar.HalfOf of its Start. A zero Half is stored as NULL.
+type Half struct { //nolint:recvcheck
+	dte.Half `example:"2006-H1" format:"half-year"`
+}
+
+func NewHalf(s string) (Half, error) {
+	half, err := dte.ParseHalf(s)
+	if err != nil {
+		return Half{}, fmt.Errorf("%w: %w", ErrNewHalf, err)
+	}
+
+	return Half{half}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (Half) GormDataType() string {
+	return "date"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (Half) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	const date = "DATE"
+
+	switch db.Dialector.Name() {
+	case "mysql":
+		return date
+	case "postgres":
+		return date
+	case "sqlserver":
+		return date
+	case "sqlite":
+		return date
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into Half. Any day of the half year scans into the half
+// year and NULL scans into the zero Half.
+func (h *Half) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return h.scanDate(string(v))
+	case string:
+		return h.scanDate(v)
+	case time.Time:
+		h.Half = dte.HalfOf(dte.Date{Time: v})
+	case nil:
+		h.Half = dte.Half{}
+	default:
+		return ErrHalfScanInvalidType
+	}
+
+	return nil
+}
+
+func (h *Half) scanDate(s string) error {
+	onlyDate, err := dte.NewDate(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrHalfScan, err)
+	}
+
+	h.Half = dte.HalfOf(onlyDate)
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the first day of the half year in the yyyy-mm-dd format, or
+// nil when it is zero.
+func (h Half) Value() (driver.Value, error) {
+	if h.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return h.Start().String(), nil
+}
@@ -0,0 +1,61 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+func TestHalf(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	half, err := dtegorm.NewHalf("H2 2024")
+	if err != nil {
+		t.Errorf("Error creating half year")
+	}
+
+	example := QuarterExample{Half: half}
+
+	dbResult := db.Create(&example)
+	if dbResult.Error != nil {
+		t.Errorf("Error creating example")
+	}
+
+	var exampleResult QuarterExample
+
+	dbResult = db.Where("half = ?", "2024-07-01").First(&exampleResult, example.ID)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting example")
+	}
+
+	if exampleResult.Half.String() != "2024-H2" || !exampleResult.Quarter.IsZero() {
+		t.Errorf("Half year is not correct, %s, %s", exampleResult.Half.String(), "2024-H2")
+	}
+}
+
+func TestHalfScan(t *testing.T) {
+	t.Parallel()
+
+	var half dtegorm.Half
+
+	err := half.Scan([]byte("2024-02-29"))
+	if err != nil || half.String() != "2024-H1" {
+		t.Errorf("Scan() = %v, %v, want 2024-H1", half, err)
+	}
+
+	err = half.Scan(2024)
+	if !errors.Is(err, dtegorm.ErrHalfScanInvalidType) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, dtegorm.ErrHalfScanInvalidType)
+	}
+
+	value, err := dtegorm.Half{}.Value()
+	if err != nil || value != nil {
+		t.Errorf("Value() = %v, %v, want nil", value, err)
+	}
+}
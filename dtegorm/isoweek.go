@@ -0,0 +1,96 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewISOWeek             = errors.New("failed to create new iso week")
+	ErrISOWeekScan            = errors.New("failed to scan value into iso week struct")
+	ErrISOWeekScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// ISOWeek stores a dte.ISOWeek as a DATE on the Monday of the week, so it sorts and compares with other dates. A
+// zero ISOWeek is stored as NULL.
+type ISOWeek struct { //nolint:recvcheck
+	dte.ISOWeek `example:"2006-W01" format:"iso-week"`
+}
+
+func NewISOWeek(s string) (ISOWeek, error) {
+	week, err := dte.ParseISOWeek(s)
+	if err != nil {
+		return ISOWeek{}, fmt.Errorf("%w: %w", ErrNewISOWeek, err)
+	}
+
+	return ISOWeek{week}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (ISOWeek) GormDataType() string {
+	return "date"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (ISOWeek) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	const date = "DATE"
+
+	switch db.Dialector.Name() {
+	case "mysql":
+		return date
+	case "postgres":
+		return date
+	case "sqlserver":
+		return date
+	case "sqlite":
+		return date
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into ISOWeek. Any day of the week scans into the week and
+// NULL scans into the zero ISOWeek.
+func (w *ISOWeek) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return w.scanDate(string(v))
+	case string:
+		return w.scanDate(v)
+	case time.Time:
+		w.ISOWeek = dte.ISOWeekOf(dte.Date{Time: v})
+	case nil:
+		w.ISOWeek = dte.ISOWeek{}
+	default:
+		return ErrISOWeekScanInvalidType
+	}
+
+	return nil
+}
+
+func (w *ISOWeek) scanDate(s string) error {
+	onlyDate, err := dte.NewDate(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrISOWeekScan, err)
+	}
+
+	w.ISOWeek = dte.ISOWeekOf(onlyDate)
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the Monday of the week in the yyyy-mm-dd format, or nil
+// when it is zero.
+func (w ISOWeek) Value() (driver.Value, error) {
+	if w.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return w.Monday().String(), nil
+}
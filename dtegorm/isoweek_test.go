@@ -0,0 +1,102 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type ISOWeekExample struct {
+	ID   uint `gorm:"primarykey"`
+	Week dtegorm.ISOWeek
+}
+
+func ExampleISOWeek() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type ISOWeekExample struct {
+		ID   uint `gorm:"primarykey"`
+		Week dtegorm.ISOWeek
+	}
+
+	week, err := dtegorm.NewISOWeek("2025-W01")
+	if err != nil {
+		return
+	}
+
+	example := ISOWeekExample{Week: week}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult ISOWeekExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Week.String())
+
+	// Output: 2025-W01
+}
+
+func TestISOWeek(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	week, err := dtegorm.NewISOWeek("2020-W53")
+	if err != nil {
+		t.Errorf("Error creating iso week")
+	}
+
+	example := ISOWeekExample{Week: week}
+
+	dbResult := db.Create(&example)
+	if dbResult.Error != nil {
+		t.Errorf("Error creating example")
+	}
+
+	var exampleResult ISOWeekExample
+
+	dbResult = db.Where("week = ?", "2020-12-28").First(&exampleResult, example.ID)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting example")
+	}
+
+	if exampleResult.Week.String() != "2020-W53" {
+		t.Errorf("ISO week is not correct, %s, %s", exampleResult.Week.String(), "2020-W53")
+	}
+}
+
+func TestISOWeekScan(t *testing.T) {
+	t.Parallel()
+
+	var week dtegorm.ISOWeek
+
+	err := week.Scan("2027-01-01")
+	if err != nil || week.String() != "2026-W53" {
+		t.Errorf("Scan() = %v, %v, want 2026-W53", week, err)
+	}
+
+	err = week.Scan(int64(1))
+	if !errors.Is(err, dtegorm.ErrISOWeekScanInvalidType) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, dtegorm.ErrISOWeekScanInvalidType)
+	}
+
+	err = week.Scan(nil)
+	if err != nil || !week.IsZero() {
+		t.Errorf("Scan() = %v, %v, want zero", week, err)
+	}
+}
@@ -0,0 +1,86 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewMonthDay             = errors.New("failed to create new month day")
+	ErrMonthDayScan            = errors.New("failed to scan value into month day struct")
+	ErrMonthDayScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// MonthDay stores a dte.MonthDay in the --mm-dd format, which sorts in the order of the days of the year so range
+// queries such as the birthdays of the next week work on the column. A zero MonthDay is stored as NULL.
+type MonthDay struct { //nolint:recvcheck
+	dte.MonthDay `example:"--01-02" format:"month-day"`
+}
+
+func NewMonthDay(s string) (MonthDay, error) {
+	monthDay, err := dte.ParseMonthDay(s)
+	if err != nil {
+		return MonthDay{}, fmt.Errorf("%w: %w", ErrNewMonthDay, err)
+	}
+
+	return MonthDay{monthDay}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (MonthDay) GormDataType() string {
+	return "string"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (MonthDay) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	const monthDay = "VARCHAR(7)"
+
+	switch db.Dialector.Name() {
+	case "mysql":
+		return monthDay
+	case "postgres":
+		return monthDay
+	case "sqlserver":
+		return monthDay
+	case "sqlite":
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into MonthDay. NULL scans into the zero MonthDay.
+func (m *MonthDay) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		err := m.UnmarshalText(v)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrMonthDayScan, err)
+		}
+	case string:
+		err := m.UnmarshalText([]byte(v))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrMonthDayScan, err)
+		}
+	case nil:
+		m.MonthDay = dte.MonthDay{}
+	default:
+		return ErrMonthDayScanInvalidType
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the --mm-dd format of MonthDay, or nil when it is zero.
+func (m MonthDay) Value() (driver.Value, error) {
+	if m.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return m.String(), nil
+}
@@ -0,0 +1,103 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type MonthDayExample struct {
+	ID       uint `gorm:"primarykey"`
+	Birthday dtegorm.MonthDay
+}
+
+func ExampleMonthDay() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type MonthDayExample struct {
+		ID       uint `gorm:"primarykey"`
+		Birthday dtegorm.MonthDay
+	}
+
+	birthday, err := dtegorm.NewMonthDay("--02-29")
+	if err != nil {
+		return
+	}
+
+	example := MonthDayExample{Birthday: birthday}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult MonthDayExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Birthday.String())
+
+	// Output: --02-29
+}
+
+func TestMonthDay(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	for _, birthday := range []string{"--12-31", "--02-29", "--03-01", "--01-15"} {
+		monthDay, err := dtegorm.NewMonthDay(birthday)
+		if err != nil {
+			t.Errorf("Error creating month day")
+		}
+
+		dbResult := db.Create(&MonthDayExample{Birthday: monthDay})
+		if dbResult.Error != nil {
+			t.Errorf("Error creating example")
+		}
+	}
+
+	var exampleResults []MonthDayExample
+
+	dbResult := db.Where("birthday BETWEEN ? AND ?", "--02-20", "--03-05").Order("birthday").Find(&exampleResults)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting examples")
+	}
+
+	if len(exampleResults) != 2 ||
+		exampleResults[0].Birthday.String() != "--02-29" || exampleResults[1].Birthday.String() != "--03-01" {
+		t.Errorf("Month days are not correct, %v", exampleResults)
+	}
+}
+
+func TestMonthDayScan(t *testing.T) {
+	t.Parallel()
+
+	var monthDay dtegorm.MonthDay
+
+	err := monthDay.Scan("--02-30")
+	if !errors.Is(err, dtegorm.ErrMonthDayScan) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, dtegorm.ErrMonthDayScan)
+	}
+
+	err = monthDay.Scan([]byte("--07-04"))
+	if err != nil || monthDay.String() != "--07-04" {
+		t.Errorf("Scan() = %v, %v, want --07-04", monthDay, err)
+	}
+
+	err = monthDay.Scan(nil)
+	if err != nil || !monthDay.IsZero() {
+		t.Errorf("Scan() = %v, %v, want zero", monthDay, err)
+	}
+}
@@ -0,0 +1,66 @@
+package dtegorm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var ErrNewPartialDate = errors.New("failed to create new partial date")
+
+// PartialDate stores a dte.PartialDate in two columns, the first day it covers as a DATE and its precision as an
+// integer, so queries can filter on the date and require a precision, for example only birth dates with a known
+// month. Embed it in a model with `gorm:"embedded;embeddedPrefix:birth_"` to get birth_start and birth_precision
+// columns. The zero PartialDate is stored as a NULL start and precision 0.
+type PartialDate struct {
+	Start     *Date                    `gorm:"column:start"`
+	Precision dte.PartialDatePrecision `gorm:"column:precision"`
+}
+
+func NewPartialDate(s string) (PartialDate, error) {
+	partial, err := dte.ParsePartialDate(s)
+	if err != nil {
+		return PartialDate{}, fmt.Errorf("%w: %w", ErrNewPartialDate, err)
+	}
+
+	return FromPartialDate(partial), nil
+}
+
+// FromPartialDate returns the columns of a dte.PartialDate.
+func FromPartialDate(p dte.PartialDate) PartialDate {
+	if p.IsZero() {
+		return PartialDate{Start: nil, Precision: 0}
+	}
+
+	return PartialDate{Start: &Date{p.Start()}, Precision: p.Precision()}
+}
+
+// PartialDate returns the dte.PartialDate the columns hold.
+func (p PartialDate) PartialDate() (dte.PartialDate, error) {
+	if p.Start == nil && p.Precision == 0 {
+		return dte.PartialDate{}, nil
+	}
+
+	if p.Start == nil {
+		return dte.PartialDate{}, fmt.Errorf("%w: precision %d without a start date", ErrNewPartialDate, p.Precision)
+	}
+
+	partial, err := dte.NewPartialDate(p.Start.Date, p.Precision)
+	if err != nil {
+		return dte.PartialDate{}, fmt.Errorf("%w: %w", ErrNewPartialDate, err)
+	}
+
+	return partial, nil
+}
+
+// String returns the partial date in the yyyy, yyyy-mm or yyyy-mm-dd format, or an empty string when the columns
+// do not hold a valid partial date.
+func (p PartialDate) String() string {
+	partial, err := p.PartialDate()
+	if err != nil {
+		return ""
+	}
+
+	return partial.String()
+}
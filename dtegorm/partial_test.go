@@ -0,0 +1,111 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type PartialDateExample struct {
+	ID        uint                `gorm:"primarykey"`
+	BirthDate dtegorm.PartialDate `gorm:"embedded;embeddedPrefix:birth_"`
+}
+
+func ExamplePartialDate() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type PartialDateExample struct {
+		ID        uint                `gorm:"primarykey"`
+		BirthDate dtegorm.PartialDate `gorm:"embedded;embeddedPrefix:birth_"`
+	}
+
+	birthDate, err := dtegorm.NewPartialDate("1974-06")
+	if err != nil {
+		return
+	}
+
+	example := PartialDateExample{BirthDate: birthDate}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult PartialDateExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.BirthDate.String())
+
+	// Output: 1974-06
+}
+
+func TestPartialDate(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	for _, birthDate := range []string{"1974", "1974-06", "1974-06-12"} {
+		partial, err := dtegorm.NewPartialDate(birthDate)
+		if err != nil {
+			t.Errorf("Error creating partial date")
+		}
+
+		dbResult := db.Create(&PartialDateExample{BirthDate: partial})
+		if dbResult.Error != nil {
+			t.Errorf("Error creating example")
+		}
+	}
+
+	var exampleResults []PartialDateExample
+
+	dbResult := db.
+		Where("birth_start BETWEEN ? AND ?", "1974-06-01", "1974-06-30").
+		Where("birth_precision >= ?", dte.PartialDatePrecisionMonth).
+		Order("birth_precision").
+		Find(&exampleResults)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting examples")
+	}
+
+	if len(exampleResults) != 2 ||
+		exampleResults[0].BirthDate.String() != "1974-06" || exampleResults[1].BirthDate.String() != "1974-06-12" {
+		t.Errorf("Partial dates are not correct, %v", exampleResults)
+	}
+}
+
+func TestPartialDateConversion(t *testing.T) {
+	t.Parallel()
+
+	zero := dtegorm.FromPartialDate(dte.PartialDate{})
+	if zero.Start != nil || zero.Precision != 0 || zero.String() != "" {
+		t.Errorf("FromPartialDate() = %v, want NULL start and precision 0", zero)
+	}
+
+	partial, err := zero.PartialDate()
+	if err != nil || !partial.IsZero() {
+		t.Errorf("PartialDate() = %v, %v, want zero", partial, err)
+	}
+
+	_, err = dtegorm.PartialDate{Start: nil, Precision: dte.PartialDatePrecisionDay}.PartialDate()
+	if !errors.Is(err, dtegorm.ErrNewPartialDate) {
+		t.Errorf("PartialDate() error = %v, wantErr %v", err, dtegorm.ErrNewPartialDate)
+	}
+
+	_, err = dtegorm.NewPartialDate("1974-6")
+	if !errors.Is(err, dtegorm.ErrNewPartialDate) {
+		t.Errorf("NewPartialDate() error = %v, wantErr %v", err, dtegorm.ErrNewPartialDate)
+	}
+}
@@ -0,0 +1,159 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewPeriod             = errors.New("failed to create new period")
+	ErrPeriodScan            = errors.New("failed to scan value into period struct")
+	ErrPeriodScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// Period stores a dte.Period as an INTERVAL on Postgres and as its ISO 8601 duration on other databases. Postgres
+// keeps months and days but folds weeks into days and carries months into years, so P2W is read back as P14D and
+// P14M as P1Y2M. Intervals with hours, minutes or seconds do not scan into a Period.
+type Period struct { //nolint:recvcheck
+	dte.Period `example:"P1Y2M10D" format:"duration"`
+}
+
+func NewPeriod(s string) (Period, error) {
+	period, err := dte.ParsePeriod(s)
+	if err != nil {
+		return Period{}, fmt.Errorf("%w: %w", ErrNewPeriod, err)
+	}
+
+	return Period{period}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (Period) GormDataType() string {
+	return "interval"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (Period) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "mysql":
+		return "VARCHAR(64)"
+	case "postgres":
+		return "INTERVAL"
+	case "sqlserver":
+		return "VARCHAR(64)"
+	case "sqlite":
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into Period. It accepts ISO 8601 durations and the default
+// postgres interval output, such as "1 year 2 mons 10 days", and NULL scans into the zero Period.
+func (p *Period) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return p.scanText(string(v))
+	case string:
+		return p.scanText(v)
+	case nil:
+		p.Period = dte.Period{}
+	default:
+		return ErrPeriodScanInvalidType
+	}
+
+	return nil
+}
+
+func (p *Period) scanText(s string) error {
+	if strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") {
+		period, err := dte.ParsePeriod(s)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrPeriodScan, err)
+		}
+
+		p.Period = period
+
+		return nil
+	}
+
+	period, err := parsePostgresInterval(s)
+	if err != nil {
+		return err
+	}
+
+	p.Period = period
+
+	return nil
+}
+
+// parsePostgresInterval parses the postgres interval output style, number and unit pairs followed by a clock that
+// must be zero.
+func parsePostgresInterval(s string) (dte.Period, error) {
+	var period dte.Period
+
+	fields := strings.Fields(s)
+
+	if len(fields) > 0 && strings.Contains(fields[len(fields)-1], ":") {
+		if strings.Trim(fields[len(fields)-1], "-+0:.") != "" {
+			return dte.Period{}, fmt.Errorf("%w: %q has a time of day", ErrPeriodScan, s)
+		}
+
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields)%2 != 0 {
+		return dte.Period{}, fmt.Errorf("%w: %q", ErrPeriodScan, s)
+	}
+
+	for i := 0; i < len(fields); i += 2 {
+		value, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return dte.Period{}, fmt.Errorf("%w: %q: %w", ErrPeriodScan, s, err)
+		}
+
+		switch strings.TrimSuffix(fields[i+1], "s") {
+		case "year":
+			period.Years = value
+		case "mon":
+			period.Months = value
+		case "day":
+			period.Days = value
+		default:
+			return dte.Period{}, fmt.Errorf("%w: %q has unit %q", ErrPeriodScan, s, fields[i+1])
+		}
+	}
+
+	return period, nil
+}
+
+// Value implements driver.Valuer interface and returns the ISO 8601 duration of Period. A negative period is written
+// with a sign on every component, as in P-1M-3D, since postgres does not accept the leading minus of -P1M3D.
+func (p Period) Value() (driver.Value, error) {
+	duration := p.String()
+	if !strings.HasPrefix(duration, "-") {
+		return duration, nil
+	}
+
+	var b strings.Builder
+
+	b.WriteString("P")
+
+	for _, unit := range []struct {
+		value      int
+		designator string
+	}{{p.Years, "Y"}, {p.Months, "M"}, {p.Weeks, "W"}, {p.Days, "D"}} {
+		if unit.value != 0 {
+			b.WriteString(strconv.Itoa(unit.value) + unit.designator)
+		}
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,165 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type PeriodExample struct {
+	ID   uint `gorm:"primarykey"`
+	Term dtegorm.Period
+}
+
+func ExamplePeriod() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type PeriodExample struct {
+		ID   uint `gorm:"primarykey"`
+		Term dtegorm.Period
+	}
+
+	term, err := dtegorm.NewPeriod("P1Y2M10D")
+	if err != nil {
+		return
+	}
+
+	example := PeriodExample{Term: term}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult PeriodExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Term.String())
+
+	// Output: P1Y2M10D
+}
+
+func TestPeriod(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	type Result struct {
+		ColumnName string
+		DataType   string
+	}
+
+	result := Result{}
+
+	db.Raw(
+		"SELECT column_name, data_type " +
+			"FROM information_schema.columns " +
+			"WHERE table_name = 'period_examples' AND column_name = 'term'",
+	).Scan(&result)
+
+	if result.ColumnName != "term" || result.DataType != "interval" {
+		t.Errorf("Column name or data type is not correct, %s, %s", result.ColumnName, result.DataType)
+	}
+
+	for input, want := range map[string]string{"P14M": "P1Y2M", "P2W": "P14D", "-P1M3D": "-P1M3D", "P0D": "P0D"} {
+		term, err := dtegorm.NewPeriod(input)
+		if err != nil {
+			t.Errorf("Error creating period")
+		}
+
+		example := PeriodExample{Term: term}
+
+		dbResult := db.Create(&example)
+		if dbResult.Error != nil {
+			t.Errorf("Error creating example")
+		}
+
+		var exampleResult PeriodExample
+
+		dbResult = db.First(&exampleResult, example.ID)
+		if dbResult.Error != nil {
+			t.Errorf("Error getting example")
+		}
+
+		if exampleResult.Term.String() != want {
+			t.Errorf("Period is not correct, %s, %s", exampleResult.Term.String(), want)
+		}
+	}
+}
+
+func TestPeriodScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    dte.Period
+		wantErr error
+	}{
+		{input: "1 year 2 mons 10 days", want: dte.Period{Years: 1, Months: 2, Days: 10}},
+		{input: "-1 mons -3 days", want: dte.Period{Months: -1, Days: -3}},
+		{input: "3 years 00:00:00", want: dte.Period{Years: 3}},
+		{input: "00:00:00", want: dte.Period{}},
+		{input: "P1Y2M10D", want: dte.Period{Years: 1, Months: 2, Days: 10}},
+		{input: "1 day 01:30:00", wantErr: dtegorm.ErrPeriodScan},
+		{input: "1 fortnight", wantErr: dtegorm.ErrPeriodScan},
+		{input: "PT1H", wantErr: dtegorm.ErrPeriodScan},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			var period dtegorm.Period
+
+			err := period.Scan([]byte(tt.input))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if period.Period != tt.want {
+				t.Errorf("Scan() = %v, want %v", period, tt.want)
+			}
+		})
+	}
+
+	period := dtegorm.Period{Period: dte.Period{Years: 1}}
+
+	err := period.Scan(nil)
+	if err != nil || !period.IsZero() {
+		t.Errorf("Scan() = %v, %v, want zero", period, err)
+	}
+
+	err = period.Scan(int64(1))
+	if !errors.Is(err, dtegorm.ErrPeriodScanInvalidType) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, dtegorm.ErrPeriodScanInvalidType)
+	}
+}
+
+func TestPeriodValue(t *testing.T) {
+	t.Parallel()
+
+	for input, want := range map[string]string{"P1Y2M": "P1Y2M", "-P1M3D": "P-1M-3D", "P1Y-2M": "P1Y-2M"} {
+		period, err := dtegorm.NewPeriod(input)
+		if err != nil {
+			t.Fatalf("NewPeriod() error = %v", err)
+		}
+
+		value, err := period.Value()
+		if err != nil || value != want {
+			t.Errorf("Value() = %v, %v, want %v", value, err, want)
+		}
+	}
+}
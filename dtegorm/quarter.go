@@ -0,0 +1,97 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewQuarter             = errors.New("failed to create new quarter")
+	ErrQuarterScan            = errors.New("failed to scan value into quarter struct")
+	ErrQuarterScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// Quarter stores a dte.Quarter as a DATE on the first day of the quarter. The date is the same for any fiscal
+// calendar, but scanning returns the calendar quarter it starts, so a fiscal quarter is read back with
+// FiscalCalendar.QuarterOf of its Start. A zero Quarter is stored as NULL.
+type Quarter struct { //nolint:recvcheck
+	dte.Quarter `example:"2006-Q1" format:"quarter"`
+}
+
+func NewQuarter(s string) (Quarter, error) {
+	quarter, err := dte.ParseQuarter(s)
+	if err != nil {
+		return Quarter{}, fmt.Errorf("%w: %w", ErrNewQuarter, err)
+	}
+
+	return Quarter{quarter}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (Quarter) GormDataType() string {
+	return "date"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (Quarter) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	const date = "DATE"
+
+	switch db.Dialector.Name() {
+	case "mysql":
+		return date
+	case "postgres":
+		return date
+	case "sqlserver":
+		return date
+	case "sqlite":
+		return date
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into Quarter. Any day of the quarter scans into the quarter
+// and NULL scans into the zero Quarter.
+func (q *Quarter) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return q.scanDate(string(v))
+	case string:
+		return q.scanDate(v)
+	case time.Time:
+		q.Quarter = dte.QuarterOf(dte.Date{Time: v})
+	case nil:
+		q.Quarter = dte.Quarter{}
+	default:
+		return ErrQuarterScanInvalidType
+	}
+
+	return nil
+}
+
+func (q *Quarter) scanDate(s string) error {
+	onlyDate, err := dte.NewDate(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrQuarterScan, err)
+	}
+
+	q.Quarter = dte.QuarterOf(onlyDate)
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the first day of the quarter in the yyyy-mm-dd format, or nil
+// when it is zero.
+func (q Quarter) Value() (driver.Value, error) {
+	if q.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return q.Start().String(), nil
+}
@@ -0,0 +1,114 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type QuarterExample struct {
+	ID      uint `gorm:"primarykey"`
+	Quarter dtegorm.Quarter
+	Half    dtegorm.Half
+}
+
+func ExampleQuarter() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type QuarterExample struct {
+		ID      uint `gorm:"primarykey"`
+		Quarter dtegorm.Quarter
+	}
+
+	quarter, err := dtegorm.NewQuarter("Q3 2024")
+	if err != nil {
+		return
+	}
+
+	example := QuarterExample{Quarter: quarter}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult QuarterExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Quarter.String())
+
+	// Output: 2024-Q3
+}
+
+func TestQuarter(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	federal, err := dte.NewFiscalCalendar(time.October, dte.FiscalYearNamedByEnd)
+	if err != nil {
+		t.Errorf("Error creating fiscal calendar")
+	}
+
+	fiscalQuarter, err := federal.Quarter(2024, 1)
+	if err != nil {
+		t.Errorf("Error creating quarter")
+	}
+
+	example := QuarterExample{Quarter: dtegorm.Quarter{fiscalQuarter}}
+
+	dbResult := db.Create(&example)
+	if dbResult.Error != nil {
+		t.Errorf("Error creating example")
+	}
+
+	var exampleResult QuarterExample
+
+	dbResult = db.Where("quarter = ?", "2023-10-01").First(&exampleResult, example.ID)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting example")
+	}
+
+	if exampleResult.Quarter.String() != "2023-Q4" || !exampleResult.Half.IsZero() {
+		t.Errorf("Quarter is not correct, %s, %s", exampleResult.Quarter.String(), "2023-Q4")
+	}
+
+	if got := federal.QuarterOf(exampleResult.Quarter.Start()); got != fiscalQuarter {
+		t.Errorf("Fiscal quarter is not correct, %s, %s", got, fiscalQuarter)
+	}
+}
+
+func TestQuarterScan(t *testing.T) {
+	t.Parallel()
+
+	var quarter dtegorm.Quarter
+
+	err := quarter.Scan("2024-05-17")
+	if err != nil || quarter.String() != "2024-Q2" {
+		t.Errorf("Scan() = %v, %v, want 2024-Q2", quarter, err)
+	}
+
+	err = quarter.Scan("2024-Q2")
+	if !errors.Is(err, dtegorm.ErrQuarterScan) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, dtegorm.ErrQuarterScan)
+	}
+
+	_, err = dtegorm.NewQuarter("2024-Q5")
+	if !errors.Is(err, dtegorm.ErrNewQuarter) {
+		t.Errorf("NewQuarter() error = %v, wantErr %v", err, dtegorm.ErrNewQuarter)
+	}
+}
@@ -0,0 +1,84 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewRRule             = errors.New("failed to create new rrule")
+	ErrRRuleScan            = errors.New("failed to scan value into rrule struct")
+	ErrRRuleScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// RRule stores a dte.RRule in its RFC 5545 string form without the RRULE: prefix, such as FREQ=MONTHLY;BYDAY=2TU, next
+// to the DTSTART column the rule is expanded from. A zero RRule is stored as NULL.
+type RRule struct { //nolint:recvcheck
+	dte.RRule `example:"FREQ=MONTHLY;BYDAY=2TU" format:"rrule"`
+}
+
+func NewRRule(s string) (RRule, error) {
+	parsed, err := dte.ParseRRule(s)
+	if err != nil {
+		return RRule{}, fmt.Errorf("%w: %w", ErrNewRRule, err)
+	}
+
+	return RRule{parsed}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (RRule) GormDataType() string {
+	return "string"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (RRule) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "mysql":
+		return "TEXT"
+	case "postgres":
+		return "TEXT"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	case "sqlite":
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into RRule. NULL scans into the zero RRule.
+func (r *RRule) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		err := r.UnmarshalText(v)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrRRuleScan, err)
+		}
+	case string:
+		err := r.UnmarshalText([]byte(v))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrRRuleScan, err)
+		}
+	case nil:
+		r.RRule = dte.RRule{}
+	default:
+		return ErrRRuleScanInvalidType
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns string format of RRule, or nil when it is zero.
+func (r RRule) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return r.String(), nil
+}
@@ -0,0 +1,166 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type RRuleExample struct {
+	ID       uint `gorm:"primarykey"`
+	Start    dtegorm.Date
+	Rule     dtegorm.RRule
+	Optional dtegorm.RRule
+}
+
+func ExampleRRule() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type RRuleExample struct {
+		ID    uint `gorm:"primarykey"`
+		Start dtegorm.Date
+		Rule  dtegorm.RRule
+	}
+
+	start, err := dtegorm.NewDate("2024-01-09")
+	if err != nil {
+		return
+	}
+
+	rule, err := dtegorm.NewRRule("RRULE:FREQ=MONTHLY;BYDAY=2TU;COUNT=3")
+	if err != nil {
+		return
+	}
+
+	example := RRuleExample{Start: start, Rule: rule}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult RRuleExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Rule.String())
+
+	for occurrence := range exampleResult.Rule.Occurrences(exampleResult.Start.Time) {
+		fmt.Println(occurrence.Format("2006-01-02"))
+	}
+
+	// Output:
+	// FREQ=MONTHLY;COUNT=3;BYDAY=2TU
+	// 2024-01-09
+	// 2024-02-13
+	// 2024-03-12
+}
+
+func TestRRule(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	type Result struct {
+		ColumnName string
+		DataType   string
+	}
+
+	result := Result{}
+
+	db.Raw(
+		"SELECT column_name, data_type " +
+			"FROM information_schema.columns " +
+			"WHERE table_name = 'r_rule_examples' AND column_name = 'rule'",
+	).Scan(&result)
+
+	if result.ColumnName != "rule" || result.DataType != "text" {
+		t.Errorf("Column name or data type is not correct, %s, %s", result.ColumnName, result.DataType)
+	}
+
+	rule, err := dtegorm.NewRRule("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;RSCALE=GREGORIAN;SKIP=BACKWARD")
+	if err != nil {
+		t.Errorf("Error creating rrule")
+	}
+
+	example := RRuleExample{Rule: rule}
+
+	dbResult := db.Create(&example)
+	if dbResult.Error != nil {
+		t.Errorf("Error creating example")
+	}
+
+	var exampleResult RRuleExample
+
+	dbResult = db.First(&exampleResult, example.ID)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting example")
+	}
+
+	if want := "FREQ=YEARLY;BYMONTHDAY=29;BYMONTH=2;RSCALE=GREGORIAN;SKIP=BACKWARD"; exampleResult.Rule.String() != want {
+		t.Errorf("RRule is not correct, %s, %s", exampleResult.Rule.String(), want)
+	}
+
+	if !exampleResult.Optional.IsZero() {
+		t.Errorf("RRule is not zero, %s", exampleResult.Optional.String())
+	}
+}
+
+func TestRRuleScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   any
+		want    string
+		wantErr error
+	}{
+		{name: "bytes", input: []byte("FREQ=DAILY;COUNT=2"), want: "FREQ=DAILY;COUNT=2"},
+		{name: "string", input: "RRULE:FREQ=WEEKLY;BYDAY=MO", want: "FREQ=WEEKLY;BYDAY=MO"},
+		{name: "null", input: nil, want: ""},
+		{name: "invalid rule", input: "FREQ=DAILY;COUNT=2;UNTIL=20240101", wantErr: dtegorm.ErrRRuleScan},
+		{name: "invalid type", input: 5, wantErr: dtegorm.ErrRRuleScanInvalidType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var rule dtegorm.RRule
+
+			err := rule.Scan(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if rule.String() != tt.want {
+				t.Errorf("Scan() = %v, want %v", rule, tt.want)
+			}
+
+			value, err := rule.Value()
+			if err != nil || tt.want == "" && value != nil || tt.want != "" && value != tt.want {
+				t.Errorf("Value() = %v, %v, want %v", value, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRRuleError(t *testing.T) {
+	t.Parallel()
+
+	_, err := dtegorm.NewRRule("FREQ=WEEKLY;BYDAY=2TU")
+	if !errors.Is(err, dtegorm.ErrNewRRule) {
+		t.Errorf("NewRRule() error = %v, wantErr %v", err, dtegorm.ErrNewRRule)
+	}
+}
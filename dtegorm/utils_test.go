@@ -70,7 +70,19 @@ func DeleteDB(dbName string, db *gorm.DB) {
 }
 
 func RunMigrations(db *gorm.DB) {
-	err := db.AutoMigrate(&TimeExample{}, &DateExample{})
+	err := db.AutoMigrate(
+		&TimeExample{},
+		&DateExample{},
+		&EDTFExample{},
+		&PartialDateExample{},
+		&YearMonthExample{},
+		&ISOWeekExample{},
+		&QuarterExample{},
+		&MonthDayExample{},
+		&PeriodExample{},
+		&DurationExample{},
+		&RRuleExample{},
+	)
 	if err != nil {
 		log.Fatal("Error migrating database")
 	}
@@ -0,0 +1,181 @@
+package dtegorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrNewYearMonth             = errors.New("failed to create new year month")
+	ErrYearMonthScan            = errors.New("failed to scan value into year month struct")
+	ErrYearMonthScanInvalidType = errors.New("invalid type passed to scan")
+)
+
+// YearMonth stores a dte.YearMonth as a DATE on the first of the month. A zero YearMonth is stored as NULL.
+type YearMonth struct { //nolint:recvcheck
+	dte.YearMonth `example:"2006-01" format:"year-month"`
+}
+
+// YearMonthInt stores a dte.YearMonth as an integer in the yyyymm form, such as 202401. A zero YearMonthInt is
+// stored as NULL.
+type YearMonthInt struct { //nolint:recvcheck
+	dte.YearMonth `example:"2006-01" format:"year-month"`
+}
+
+func NewYearMonth(s string) (YearMonth, error) {
+	yearMonth, err := dte.ParseYearMonth(s)
+	if err != nil {
+		return YearMonth{}, fmt.Errorf("%w: %w", ErrNewYearMonth, err)
+	}
+
+	return YearMonth{yearMonth}, nil
+}
+
+func NewYearMonthInt(s string) (YearMonthInt, error) {
+	yearMonth, err := dte.ParseYearMonth(s)
+	if err != nil {
+		return YearMonthInt{}, fmt.Errorf("%w: %w", ErrNewYearMonth, err)
+	}
+
+	return YearMonthInt{yearMonth}, nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (YearMonth) GormDataType() string {
+	return "date"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (YearMonth) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	const date = "DATE"
+
+	switch db.Dialector.Name() {
+	case "mysql":
+		return date
+	case "postgres":
+		return date
+	case "sqlserver":
+		return date
+	case "sqlite":
+		return date
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into YearMonth. NULL scans into the zero YearMonth.
+func (y *YearMonth) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return y.scanDate(string(v))
+	case string:
+		return y.scanDate(v)
+	case time.Time:
+		y.YearMonth = dte.YearMonthOf(dte.Date{Time: v})
+	case nil:
+		y.YearMonth = dte.YearMonth{}
+	default:
+		return ErrYearMonthScanInvalidType
+	}
+
+	return nil
+}
+
+func (y *YearMonth) scanDate(s string) error {
+	onlyDate, err := dte.NewDate(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrYearMonthScan, err)
+	}
+
+	y.YearMonth = dte.YearMonthOf(onlyDate)
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the first day of the month in the yyyy-mm-dd format, or nil
+// when it is zero.
+func (y YearMonth) Value() (driver.Value, error) {
+	if y.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return y.FirstDay().String(), nil
+}
+
+// GormDataType returns gorm common data type. This type is used for the field's column type.
+func (YearMonthInt) GormDataType() string {
+	return "int"
+}
+
+// GormDBDataType returns gorm DB data type based on the current using database.
+func (YearMonthInt) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	const integer = "INTEGER"
+
+	switch db.Dialector.Name() {
+	case "mysql":
+		return integer
+	case "postgres":
+		return integer
+	case "sqlserver":
+		return "INT"
+	case "sqlite":
+		return integer
+	default:
+		return ""
+	}
+}
+
+// Scan implements sql.Scanner interface and scans value into YearMonthInt. NULL scans into the zero YearMonthInt.
+func (y *YearMonthInt) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case int64:
+		return y.scanInt(v)
+	case []byte:
+		yyyymm, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrYearMonthScan, err)
+		}
+
+		return y.scanInt(yyyymm)
+	case string:
+		yyyymm, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrYearMonthScan, err)
+		}
+
+		return y.scanInt(yyyymm)
+	case nil:
+		y.YearMonth = dte.YearMonth{}
+	default:
+		return ErrYearMonthScanInvalidType
+	}
+
+	return nil
+}
+
+func (y *YearMonthInt) scanInt(yyyymm int64) error {
+	yearMonth, err := dte.YearMonthFromInt(int(yyyymm))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrYearMonthScan, err)
+	}
+
+	y.YearMonth = yearMonth
+
+	return nil
+}
+
+// Value implements driver.Valuer interface and returns the month in the yyyymm form, or nil when it is zero.
+func (y YearMonthInt) Value() (driver.Value, error) {
+	if y.IsZero() {
+		return nil, nil //nolint:nilnil
+	}
+
+	return int64(y.Int()), nil
+}
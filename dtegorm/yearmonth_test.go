@@ -0,0 +1,137 @@
+package dtegorm_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtegorm"
+)
+
+type YearMonthExample struct {
+	ID     uint `gorm:"primarykey"`
+	Period dtegorm.YearMonth
+	Expiry dtegorm.YearMonthInt
+}
+
+func ExampleYearMonth() {
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+	// ^^^ Setup for the PG DB. This can be ignored
+
+	type YearMonthExample struct {
+		ID     uint `gorm:"primarykey"`
+		Period dtegorm.YearMonth
+	}
+
+	period, err := dtegorm.NewYearMonth("January 2024")
+	if err != nil {
+		return
+	}
+
+	example := YearMonthExample{Period: period}
+
+	createResult := db.Create(&example)
+	if createResult.Error != nil {
+		return
+	}
+
+	var exampleResult YearMonthExample
+
+	getResult := db.First(&exampleResult, example.ID)
+	if getResult.Error != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.Period.String())
+
+	// Output: 2024-01
+}
+
+func TestYearMonth(t *testing.T) {
+	t.Parallel()
+
+	dbName, dsn, db := Setup()
+	dsn = strings.ReplaceAll(dsn, "dbname="+dbName, "dbname=postgres")
+	defer Teardown(dbName, dsn, db)
+
+	type Result struct {
+		ColumnName string
+		DataType   string
+	}
+
+	for column, dataType := range map[string]string{"period": "date", "expiry": "integer"} {
+		result := Result{}
+
+		db.Raw(
+			"SELECT column_name, data_type "+
+				"FROM information_schema.columns "+
+				"WHERE table_name = 'year_month_examples' AND column_name = ?", column,
+		).Scan(&result)
+
+		if result.ColumnName != column || result.DataType != dataType {
+			t.Errorf("Column name or data type is not correct, %s, %s", result.ColumnName, result.DataType)
+		}
+	}
+
+	period, err := dtegorm.NewYearMonth("2024-02")
+	if err != nil {
+		t.Errorf("Error creating year month")
+	}
+
+	expiry, err := dtegorm.NewYearMonthInt("09/27")
+	if err != nil {
+		t.Errorf("Error creating year month")
+	}
+
+	example := YearMonthExample{Period: period, Expiry: expiry}
+
+	dbResult := db.Create(&example)
+	if dbResult.Error != nil {
+		t.Errorf("Error creating example")
+	}
+
+	var exampleResult YearMonthExample
+
+	dbResult = db.Where("expiry >= ?", 202701).First(&exampleResult, example.ID)
+	if dbResult.Error != nil {
+		t.Errorf("Error getting example")
+	}
+
+	if exampleResult.Period.String() != "2024-02" || exampleResult.Expiry.String() != "2027-09" {
+		t.Errorf("Year months are not correct, %s, %s", exampleResult.Period.String(), exampleResult.Expiry.String())
+	}
+}
+
+func TestYearMonthScan(t *testing.T) {
+	t.Parallel()
+
+	var yearMonth dtegorm.YearMonthInt
+
+	err := yearMonth.Scan(int64(202413))
+	if !errors.Is(err, dtegorm.ErrYearMonthScan) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, dtegorm.ErrYearMonthScan)
+	}
+
+	err = yearMonth.Scan([]byte("202412"))
+	if err != nil || yearMonth.String() != "2024-12" {
+		t.Errorf("Scan() = %v, %v, want 2024-12", yearMonth, err)
+	}
+
+	err = yearMonth.Scan(nil)
+	if err != nil || !yearMonth.IsZero() {
+		t.Errorf("Scan() = %v, %v, want zero", yearMonth, err)
+	}
+
+	value, err := yearMonth.Value()
+	if err != nil || value != nil {
+		t.Errorf("Value() = %v, %v, want nil", value, err)
+	}
+
+	_, err = dtegorm.NewYearMonth("2024/01")
+	if !errors.Is(err, dtegorm.ErrNewYearMonth) {
+		t.Errorf("NewYearMonth() error = %v, wantErr %v", err, dtegorm.ErrNewYearMonth)
+	}
+}
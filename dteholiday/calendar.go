@@ -0,0 +1,160 @@
+package dteholiday
+
+import (
+	"slices"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+// Holiday is a holiday with the rule its date is found by and the years it is held in.
+type Holiday struct {
+	// Name is the name of the holiday.
+	Name string `json:"name"`
+	// Rule finds the date of the holiday in a year.
+	Rule Rule `json:"rule"`
+	// Observance is the day the holiday is observed on when it falls on the weekend.
+	Observance Observance `json:"observance,omitempty"`
+	// From is the first year the holiday is held in, or 0 when it has always been.
+	From int `json:"from,omitempty"`
+	// Until is the last year the holiday is held in, or 0 when it still is.
+	Until int `json:"until,omitempty"`
+	// Moved are the years the holiday was moved to another date by proclamation, and the dates it was held on, which
+	// are observed as they are.
+	Moved map[int]dte.Date `json:"moved,omitempty"`
+}
+
+// Occurrence is a holiday in a year.
+type Occurrence struct {
+	// Name is the name of the holiday.
+	Name string
+	// Date is the date of the holiday in the year.
+	Date dte.Date
+	// Observed is the date the holiday is observed on, which is its date unless it falls on the weekend or was moved.
+	Observed dte.Date
+}
+
+// Calendar is a set of holidays and the weekend they are observed around. It is a dte.HolidayCalendar closed on the
+// dates its holidays are observed on, not counting the weekend, which Business adds.
+type Calendar struct {
+	// Name is the name of the jurisdiction the holidays are held in.
+	Name string
+	// Weekend is the days of the week business is closed on, which holidays are moved off by their observance.
+	Weekend dte.Weekend
+	// Holidays are the holidays of the calendar.
+	Holidays []Holiday
+}
+
+// Date returns the date the holiday is held on in the year, or false when it is not held in the year.
+func (h Holiday) Date(year int) (dte.Date, bool) {
+	if (h.From != 0 && year < h.From) || (h.Until != 0 && year > h.Until) {
+		return dte.Date{}, false
+	}
+
+	if moved, ok := h.Moved[year]; ok {
+		return moved, true
+	}
+
+	return h.Rule.Date(year)
+}
+
+// Occurrences returns the holidays held in the year, ordered by the date they are observed on, with a holiday that
+// is listed more than once for the same date only once. A holiday observed on the weekday nearest to it may be
+// observed in the year before or after, such as a New Year's Day on a Saturday.
+func (c Calendar) Occurrences(year int) []Occurrence {
+	occurrences := make([]Occurrence, 0, len(c.Holidays))
+	substitutes := make([]int, 0)
+
+	for _, holiday := range c.Holidays {
+		date, ok := holiday.Date(year)
+		if !ok {
+			continue
+		}
+
+		occurrence := Occurrence{Name: holiday.Name, Date: date, Observed: date}
+
+		// A holiday may be listed more than once, such as for a region and for a single year everywhere.
+		if slices.ContainsFunc(occurrences, func(listed Occurrence) bool {
+			return listed.Name == holiday.Name && listed.Date.Equal(date.Time)
+		}) {
+			continue
+		}
+
+		if _, moved := holiday.Moved[year]; !moved && c.Weekend.Contains(date.Weekday()) {
+			switch holiday.Observance {
+			case ObserveOnDate:
+			case ObserveNearestWeekday:
+				occurrence.Observed = c.nearestWeekday(date)
+			case ObserveSubstituteDay:
+				substitutes = append(substitutes, len(occurrences))
+			}
+		}
+
+		occurrences = append(occurrences, occurrence)
+	}
+
+	// Substitute days go after the holidays observed on their own dates, taking the first free day in date order.
+	slices.SortStableFunc(substitutes, func(a int, b int) int {
+		return occurrences[a].Date.Compare(occurrences[b].Date.Time)
+	})
+
+	for _, i := range substitutes {
+		observed := occurrences[i].Date
+
+		for c.Weekend.Contains(observed.Weekday()) || observedOn(occurrences, observed, i) {
+			observed = dte.Date{Time: observed.AddDate(0, 0, 1)}
+		}
+
+		occurrences[i].Observed = observed
+	}
+
+	slices.SortStableFunc(occurrences, func(a Occurrence, b Occurrence) int {
+		return a.Observed.Compare(b.Observed.Time)
+	})
+
+	return occurrences
+}
+
+// IsHoliday reports whether a holiday is observed on the date, which implements dte.HolidayCalendar.
+func (c Calendar) IsHoliday(date dte.Date) bool {
+	for year := date.Year() - 1; year <= date.Year()+1; year++ {
+		for _, occurrence := range c.Occurrences(year) {
+			if occurrence.Observed.Equal(date.Time) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Business returns the business calendar closed on the weekend and the dates the holidays are observed on.
+func (c Calendar) Business() dte.BusinessCalendar {
+	return dte.BusinessCalendar{Weekend: c.Weekend, Holidays: c}
+}
+
+// nearestWeekday returns the nearest day to the date that is not in the weekend, the later one when two are as near.
+func (c Calendar) nearestWeekday(date dte.Date) dte.Date {
+	for days := 1; days < daysPerWeek; days++ {
+		if after := date.AddDate(0, 0, days); !c.Weekend.Contains(after.Weekday()) {
+			return dte.Date{Time: after}
+		}
+
+		if before := date.AddDate(0, 0, -days); !c.Weekend.Contains(before.Weekday()) {
+			return dte.Date{Time: before}
+		}
+	}
+
+	return date
+}
+
+// observedOn reports whether a holiday other than the ith is observed on the date. Substitutes that have not been
+// moved yet are observed on their weekend dates, which a substitute is never moved to.
+func observedOn(occurrences []Occurrence, date dte.Date, i int) bool {
+	for j, occurrence := range occurrences {
+		if j != i && occurrence.Observed.Equal(date.Time) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,207 @@
+package dteholiday_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteholiday"
+)
+
+func ExampleCalendar_Occurrences() {
+	calendar := dteholiday.Calendar{
+		Name:    "Example",
+		Weekend: dte.SaturdaySunday,
+		Holidays: []dteholiday.Holiday{
+			{Name: "Christmas Day", Rule: mustRule("December 25"), Observance: dteholiday.ObserveSubstituteDay},
+			{Name: "Boxing Day", Rule: mustRule("December 26"), Observance: dteholiday.ObserveSubstituteDay},
+		},
+	}
+
+	for _, occurrence := range calendar.Occurrences(2021) {
+		fmt.Println(occurrence.Observed, occurrence.Name, occurrence.Date.Weekday())
+	}
+
+	// Output:
+	// 2021-12-27 Christmas Day Saturday
+	// 2021-12-28 Boxing Day Sunday
+}
+
+func TestCalendarObservance(t *testing.T) {
+	t.Parallel()
+
+	holidays := []dteholiday.Holiday{
+		{Name: "New Year's Day", Rule: mustRule("January 1"), Observance: dteholiday.ObserveNearestWeekday},
+		{Name: "2nd January", Rule: mustRule("January 2"), Observance: dteholiday.ObserveSubstituteDay},
+		{Name: "National Day", Rule: mustRule("June 6"), Observance: dteholiday.ObserveOnDate},
+		{Name: "Christmas Day", Rule: mustRule("December 25"), Observance: dteholiday.ObserveSubstituteDay},
+		{Name: "Boxing Day", Rule: mustRule("December 26"), Observance: dteholiday.ObserveSubstituteDay},
+	}
+
+	tests := []struct {
+		name    string
+		weekend dte.Weekend
+		year    int
+		want    []string
+	}{
+		{
+			name:    "no weekend days",
+			weekend: dte.SaturdaySunday,
+			year:    2024,
+			want:    []string{"2024-01-01", "2024-01-02", "2024-06-06", "2024-12-25", "2024-12-26"},
+		},
+		{
+			name:    "substitutes after a holiday on its date",
+			weekend: dte.SaturdaySunday,
+			year:    2022,
+			want:    []string{"2021-12-31", "2022-01-03", "2022-06-06", "2022-12-27", "2022-12-26"},
+		},
+		{
+			name:    "substitute after its own weekend",
+			weekend: dte.SaturdaySunday,
+			year:    2021,
+			want:    []string{"2021-01-01", "2021-01-04", "2021-06-06", "2021-12-27", "2021-12-28"},
+		},
+		{
+			name:    "friday and saturday weekend",
+			weekend: dte.FridaySaturday,
+			year:    2021,
+			want:    []string{"2020-12-31", "2021-01-03", "2021-06-06", "2021-12-27", "2021-12-26"},
+		},
+		{
+			name:    "no weekend",
+			weekend: 0,
+			year:    2022,
+			want:    []string{"2022-01-01", "2022-01-02", "2022-06-06", "2022-12-25", "2022-12-26"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar := dteholiday.Calendar{Name: tt.name, Weekend: tt.weekend, Holidays: holidays}
+
+			occurrences := calendar.Occurrences(tt.year)
+			if len(occurrences) != len(tt.want) {
+				t.Fatalf("Occurrences() = %v, want %d of them", occurrences, len(tt.want))
+			}
+
+			for i, occurrence := range occurrences {
+				if i > 0 && occurrence.Observed.Before(occurrences[i-1].Observed.Time) {
+					t.Errorf("Occurrences() are not in observed order: %v", occurrences)
+				}
+			}
+
+			for i, holiday := range holidays {
+				for _, occurrence := range occurrences {
+					if occurrence.Name == holiday.Name && occurrence.Observed.String() != tt.want[i] {
+						t.Errorf("%s observed on %s, want %s", holiday.Name, occurrence.Observed, tt.want[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarYears(t *testing.T) {
+	t.Parallel()
+
+	moved := mustDate(t, "2020-05-08")
+	calendar := dteholiday.Calendar{
+		Name:    "Example",
+		Weekend: dte.SaturdaySunday,
+		Holidays: []dteholiday.Holiday{
+			{Name: "Old", Rule: mustRule("May 30"), Until: 1970},
+			{Name: "New", Rule: mustRule("last Monday of May"), From: 1971},
+			{Name: "Early May", Rule: mustRule("first Monday of May"), Moved: map[int]dte.Date{2020: moved}},
+			{Name: "Jubilee", Rule: mustRule("2022-06-03")},
+			{Name: "Jubilee", Rule: mustRule("2022-06-03")},
+		},
+	}
+
+	tests := []struct {
+		year int
+		want string
+	}{
+		{1970, "1970-05-04 Early May, 1970-05-30 Old"},
+		{1971, "1971-05-03 Early May, 1971-05-31 New"},
+		{2020, "2020-05-08 Early May, 2020-05-25 New"},
+		{2022, "2022-05-02 Early May, 2022-05-30 New, 2022-06-03 Jubilee"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.year), func(t *testing.T) {
+			t.Parallel()
+
+			if got := formatOccurrences(calendar.Occurrences(tt.year)); got != tt.want {
+				t.Errorf("Occurrences(%d) = %s, want %s", tt.year, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarBusiness(t *testing.T) {
+	t.Parallel()
+
+	calendar := dteholiday.Calendar{
+		Name:    "Example",
+		Weekend: dte.SaturdaySunday,
+		Holidays: []dteholiday.Holiday{
+			{Name: "New Year's Day", Rule: mustRule("January 1"), Observance: dteholiday.ObserveNearestWeekday},
+		},
+	}
+
+	// New Year's Day 2022 is a Saturday, observed on the Friday of the year before.
+	if !calendar.IsHoliday(mustDate(t, "2021-12-31")) {
+		t.Errorf("IsHoliday(2021-12-31) = false, want true")
+	}
+
+	if calendar.IsHoliday(mustDate(t, "2022-01-01")) {
+		t.Errorf("IsHoliday(2022-01-01) = true, want false for the weekend date")
+	}
+
+	business := calendar.Business()
+
+	if got := business.NextBusinessDay(mustDate(t, "2021-12-30")); got.String() != "2022-01-03" {
+		t.Errorf("NextBusinessDay(2021-12-30) = %s, want 2022-01-03", got)
+	}
+
+	if got := business.BusinessDaysBetween(mustDate(t, "2021-12-01"), mustDate(t, "2021-12-31")); got != 21 {
+		t.Errorf("BusinessDaysBetween() = %d, want 21", got)
+	}
+}
+
+func mustRule(text string) dteholiday.Rule {
+	rule, err := dteholiday.ParseRule(text)
+	if err != nil {
+		panic(err)
+	}
+
+	return rule
+}
+
+func mustDate(t *testing.T, text string) dte.Date {
+	t.Helper()
+
+	date, err := dte.NewDate(text)
+	if err != nil {
+		t.Fatalf("NewDate() error = %v", err)
+	}
+
+	return date
+}
+
+func formatOccurrences(occurrences []dteholiday.Occurrence) string {
+	text := ""
+
+	for i, occurrence := range occurrences {
+		if i > 0 {
+			text += ", "
+		}
+
+		text += occurrence.Observed.String() + " " + occurrence.Name
+	}
+
+	return text
+}
@@ -0,0 +1,153 @@
+package dteholiday
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrJurisdiction = errors.New("holiday calendar jurisdiction is not known")
+	ErrData         = errors.New("holiday calendar data is not valid")
+)
+
+//go:embed data/*.json
+var data embed.FS //nolint:gochecknoglobals
+
+// countryFile is the data file of the holidays of a country and its regions.
+type countryFile struct {
+	// Name is the name of the country.
+	Name string `json:"name"`
+	// Weekend is the names of the days of the week of the weekend.
+	Weekend []string `json:"weekend"`
+	// Regions are the names of the regions by their ISO 3166-2 subdivision codes without the country code.
+	Regions map[string]string `json:"regions"`
+	// Holidays are the holidays of the whole country, and of the regions they list.
+	Holidays []regionalHoliday `json:"holidays"`
+}
+
+// regionalHoliday is a holiday of a data file, held in the whole country when it lists no regions.
+type regionalHoliday struct {
+	Holiday
+
+	Regions []string `json:"regions,omitempty"`
+}
+
+// Jurisdictions returns the codes of the bundled calendars, ISO 3166-1 country codes such as US for the holidays of a
+// whole country and ISO 3166-2 subdivision codes such as GB-SCT or DE-BY for those of a region, sorted.
+func Jurisdictions() []string {
+	var jurisdictions []string
+
+	files, _ := fs.Glob(data, "data/*.json")
+
+	for _, file := range files {
+		country := strings.ToUpper(strings.TrimSuffix(path.Base(file), ".json"))
+		jurisdictions = append(jurisdictions, country)
+
+		parsed, err := readCountry(country)
+		if err != nil {
+			continue
+		}
+
+		for region := range parsed.Regions {
+			jurisdictions = append(jurisdictions, country+"-"+region)
+		}
+	}
+
+	slices.Sort(jurisdictions)
+
+	return jurisdictions
+}
+
+// Load returns the bundled calendar of a jurisdiction listed by Jurisdictions, matched without regard to case. The
+// calendar of a country has the holidays of the whole country, and that of a region adds those of the region.
+func Load(jurisdiction string) (Calendar, error) {
+	country, region, regional := strings.Cut(strings.ToUpper(jurisdiction), "-")
+
+	parsed, err := readCountry(country)
+	if err != nil {
+		return Calendar{}, fmt.Errorf("Load %q: %w", jurisdiction, err)
+	}
+
+	calendar := Calendar{Name: parsed.Name, Weekend: 0, Holidays: nil}
+
+	if regional {
+		name, ok := parsed.Regions[region]
+		if !ok {
+			return Calendar{}, fmt.Errorf("Load %q: %w: %s has no region %s", jurisdiction, ErrJurisdiction,
+				parsed.Name, region)
+		}
+
+		calendar.Name = name + ", " + parsed.Name
+	}
+
+	calendar.Weekend, err = parseWeekend(parsed.Weekend)
+	if err != nil {
+		return Calendar{}, fmt.Errorf("Load %q: %w", jurisdiction, err)
+	}
+
+	for _, holiday := range parsed.Holidays {
+		for _, listed := range holiday.Regions {
+			if _, ok := parsed.Regions[listed]; !ok {
+				return Calendar{}, fmt.Errorf("Load %q: %w: %s lists unknown region %s", jurisdiction, ErrData,
+					holiday.Name, listed)
+			}
+		}
+
+		if len(holiday.Regions) == 0 || slices.Contains(holiday.Regions, region) {
+			calendar.Holidays = append(calendar.Holidays, holiday.Holiday)
+		}
+	}
+
+	return calendar, nil
+}
+
+// readCountry reads the data file of the country.
+func readCountry(country string) (countryFile, error) {
+	if country == "" || strings.ContainsAny(country, "/.") {
+		return countryFile{}, fmt.Errorf("%w: %q is not a country code", ErrJurisdiction, country)
+	}
+
+	content, err := data.ReadFile("data/" + strings.ToLower(country) + ".json")
+	if err != nil {
+		return countryFile{}, fmt.Errorf("%w: there is no calendar of %s", ErrJurisdiction, country)
+	}
+
+	var parsed countryFile
+
+	err = json.Unmarshal(content, &parsed)
+	if err != nil {
+		return countryFile{}, fmt.Errorf("%w: %s: %w", ErrData, country, err)
+	}
+
+	return parsed, nil
+}
+
+// parseWeekend parses the names of the days of the week of a weekend.
+func parseWeekend(names []string) (dte.Weekend, error) {
+	days := make([]time.Weekday, 0, len(names))
+
+	for _, name := range names {
+		day, err := parseWeekday(name)
+		if err != nil {
+			return 0, fmt.Errorf("%w: weekend: %w", ErrData, err)
+		}
+
+		days = append(days, day)
+	}
+
+	weekend, err := dte.NewWeekend(days...)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrData, err)
+	}
+
+	return weekend, nil
+}
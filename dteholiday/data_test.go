@@ -0,0 +1,170 @@
+package dteholiday_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dteholiday"
+)
+
+func ExampleLoad() {
+	calendar, err := dteholiday.Load("GB-SCT")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(calendar.Name)
+
+	for _, occurrence := range calendar.Occurrences(2024)[:2] {
+		fmt.Println(occurrence.Observed, occurrence.Name)
+	}
+
+	// Output:
+	// Scotland, United Kingdom
+	// 2024-01-01 New Year's Day
+	// 2024-01-02 2nd January
+}
+
+func TestJurisdictions(t *testing.T) {
+	t.Parallel()
+
+	jurisdictions := dteholiday.Jurisdictions()
+
+	for _, want := range []string{"US", "GB", "GB-ENG", "GB-NIR", "GB-SCT", "GB-WLS", "DE", "DE-BY", "DE-SN"} {
+		if !slices.Contains(jurisdictions, want) {
+			t.Errorf("Jurisdictions() = %v, want it to contain %s", jurisdictions, want)
+		}
+	}
+
+	if !slices.IsSorted(jurisdictions) {
+		t.Errorf("Jurisdictions() = %v, want them sorted", jurisdictions)
+	}
+
+	// Every bundled calendar loads and gives dates in every year it covers.
+	for _, jurisdiction := range jurisdictions {
+		calendar, err := dteholiday.Load(jurisdiction)
+		if err != nil {
+			t.Errorf("Load(%s) error = %v", jurisdiction, err)
+
+			continue
+		}
+
+		for year := 1950; year <= 2100; year++ {
+			if len(calendar.Occurrences(year)) == 0 {
+				t.Errorf("Load(%s).Occurrences(%d) is empty", jurisdiction, year)
+			}
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		jurisdiction string
+		year         int
+		want         string
+	}{
+		{
+			jurisdiction: "US",
+			year:         2021,
+			want: "2021-01-01 New Year's Day, 2021-01-18 Birthday of Martin Luther King, Jr., " +
+				"2021-02-15 Washington's Birthday, 2021-05-31 Memorial Day, " +
+				"2021-06-18 Juneteenth National Independence Day, 2021-07-05 Independence Day, " +
+				"2021-09-06 Labor Day, 2021-10-11 Columbus Day, 2021-11-11 Veterans Day, " +
+				"2021-11-25 Thanksgiving Day, 2021-12-24 Christmas Day",
+		},
+		{
+			jurisdiction: "us",
+			year:         1975,
+			want: "1975-01-01 New Year's Day, 1975-02-17 Washington's Birthday, 1975-05-26 Memorial Day, " +
+				"1975-07-04 Independence Day, 1975-09-01 Labor Day, 1975-10-13 Columbus Day, " +
+				"1975-10-27 Veterans Day, 1975-11-27 Thanksgiving Day, 1975-12-25 Christmas Day",
+		},
+		{
+			jurisdiction: "GB-ENG",
+			year:         2022,
+			want: "2022-01-03 New Year's Day, 2022-04-15 Good Friday, 2022-04-18 Easter Monday, " +
+				"2022-05-02 Early May bank holiday, 2022-06-02 Spring bank holiday, " +
+				"2022-06-03 Platinum Jubilee of Queen Elizabeth II, 2022-08-29 Summer bank holiday, " +
+				"2022-09-19 State Funeral of Queen Elizabeth II, 2022-12-26 Boxing Day, 2022-12-27 Christmas Day",
+		},
+		{
+			jurisdiction: "GB-NIR",
+			year:         2023,
+			want: "2023-01-02 New Year's Day, 2023-03-17 St Patrick's Day, 2023-04-07 Good Friday, " +
+				"2023-04-10 Easter Monday, 2023-05-01 Early May bank holiday, " +
+				"2023-05-08 Coronation of King Charles III, 2023-05-29 Spring bank holiday, " +
+				"2023-07-12 Battle of the Boyne, 2023-08-28 Summer bank holiday, 2023-12-25 Christmas Day, " +
+				"2023-12-26 Boxing Day",
+		},
+		{
+			jurisdiction: "GB-SCT",
+			year:         2021,
+			want: "2021-01-01 New Year's Day, 2021-01-04 2nd January, 2021-04-02 Good Friday, " +
+				"2021-05-03 Early May bank holiday, 2021-05-31 Spring bank holiday, " +
+				"2021-08-02 Summer bank holiday, 2021-11-30 St Andrew's Day, 2021-12-27 Christmas Day, " +
+				"2021-12-28 Boxing Day",
+		},
+		{
+			jurisdiction: "DE",
+			year:         2017,
+			want: "2017-01-01 New Year's Day, 2017-04-14 Good Friday, 2017-04-17 Easter Monday, " +
+				"2017-05-01 Labour Day, 2017-05-25 Ascension Day, 2017-06-05 Whit Monday, " +
+				"2017-10-03 German Unity Day, 2017-10-31 Reformation Day, 2017-12-25 Christmas Day, " +
+				"2017-12-26 Second Day of Christmas",
+		},
+		{
+			jurisdiction: "DE-SN",
+			year:         2017,
+			want: "2017-01-01 New Year's Day, 2017-04-14 Good Friday, 2017-04-17 Easter Monday, " +
+				"2017-05-01 Labour Day, 2017-05-25 Ascension Day, 2017-06-05 Whit Monday, " +
+				"2017-10-03 German Unity Day, 2017-10-31 Reformation Day, " +
+				"2017-11-22 Repentance and Prayer Day, 2017-12-25 Christmas Day, " +
+				"2017-12-26 Second Day of Christmas",
+		},
+		{
+			jurisdiction: "de-by",
+			year:         2024,
+			want: "2024-01-01 New Year's Day, 2024-01-06 Epiphany, 2024-03-29 Good Friday, " +
+				"2024-04-01 Easter Monday, 2024-05-01 Labour Day, 2024-05-09 Ascension Day, " +
+				"2024-05-20 Whit Monday, 2024-05-30 Corpus Christi, 2024-10-03 German Unity Day, " +
+				"2024-11-01 All Saints' Day, 2024-12-25 Christmas Day, 2024-12-26 Second Day of Christmas",
+		},
+		{
+			jurisdiction: "DE-BE",
+			year:         2025,
+			want: "2025-01-01 New Year's Day, 2025-03-08 International Women's Day, 2025-04-18 Good Friday, " +
+				"2025-04-21 Easter Monday, 2025-05-01 Labour Day, 2025-05-08 Liberation Day, " +
+				"2025-05-29 Ascension Day, 2025-06-09 Whit Monday, 2025-10-03 German Unity Day, " +
+				"2025-12-25 Christmas Day, 2025-12-26 Second Day of Christmas",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.jurisdiction, tt.year), func(t *testing.T) {
+			t.Parallel()
+
+			calendar, err := dteholiday.Load(tt.jurisdiction)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if got := formatOccurrences(calendar.Occurrences(tt.year)); got != tt.want {
+				t.Errorf("Occurrences(%d) = %s, want %s", tt.year, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, jurisdiction := range []string{"", "XX", "GB-XYZ", "DE-", "../us", "data/us"} {
+		if _, err := dteholiday.Load(jurisdiction); !errors.Is(err, dteholiday.ErrJurisdiction) {
+			t.Errorf("Load(%q) error = %v, want %v", jurisdiction, err, dteholiday.ErrJurisdiction)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package dteholiday
+
+import (
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+// Easter returns the date of Easter Sunday of the year in the Western churches, by the Gregorian computus.
+func Easter(year int) dte.Date { //nolint:mnd
+	// The anonymous Gregorian algorithm, as given by Meeus.
+	golden := year % 19
+	century, yearOfCentury := year/100, year%100
+	leapCenturies, centuryRemainder := century/4, century%4
+	correction := (century - (century+8)/25 + 1) / 3
+	epact := (19*golden + century - leapCenturies - correction + 15) % 30
+	weekday := (32 + 2*centuryRemainder + 2*(yearOfCentury/4) - epact - yearOfCentury%4) % 7
+	shift := (golden + 11*epact + 22*weekday) / 451
+	days := epact + weekday - 7*shift + 114
+
+	return dte.Date{Time: time.Date(year, time.Month(days/31), days%31+1, 0, 0, 0, 0, time.UTC)}
+}
+
+// OrthodoxEaster returns the date of Easter Sunday of the year in the Eastern Orthodox churches, which reckon it by
+// the Julian computus, as a date of the Gregorian calendar.
+func OrthodoxEaster(year int) dte.Date { //nolint:mnd
+	// The Julian algorithm, as given by Meeus, and the days the Julian calendar is behind the Gregorian one.
+	moon := (19*(year%19) + 15) % 30
+	sunday := (2*(year%4) + 4*(year%7) - moon + 34) % 7
+	days := moon + sunday + 114
+	behind := year/100 - year/400 - 2
+
+	return dte.Date{Time: time.Date(year, time.Month(days/31), days%31+1+behind, 0, 0, 0, 0, time.UTC)}
+}
@@ -0,0 +1,49 @@
+package dteholiday_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dteholiday"
+)
+
+func ExampleEaster() {
+	fmt.Println(dteholiday.Easter(2024), dteholiday.OrthodoxEaster(2024))
+
+	// Output: 2024-03-31 2024-05-05
+}
+
+func TestEaster(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		year     int
+		western  string
+		orthodox string
+	}{
+		{1818, "1818-03-22", "1818-04-26"},
+		{1943, "1943-04-25", "1943-04-25"},
+		{2000, "2000-04-23", "2000-04-30"},
+		{2010, "2010-04-04", "2010-04-04"},
+		{2019, "2019-04-21", "2019-04-28"},
+		{2021, "2021-04-04", "2021-05-02"},
+		{2023, "2023-04-09", "2023-04-16"},
+		{2025, "2025-04-20", "2025-04-20"},
+		{2038, "2038-04-25", "2038-04-25"},
+		{2100, "2100-03-28", "2100-05-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.year), func(t *testing.T) {
+			t.Parallel()
+
+			if got := dteholiday.Easter(tt.year); got.String() != tt.western {
+				t.Errorf("Easter(%d) = %s, want %s", tt.year, got, tt.western)
+			}
+
+			if got := dteholiday.OrthodoxEaster(tt.year); got.String() != tt.orthodox {
+				t.Errorf("OrthodoxEaster(%d) = %s, want %s", tt.year, got, tt.orthodox)
+			}
+		})
+	}
+}
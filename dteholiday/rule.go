@@ -0,0 +1,378 @@
+package dteholiday
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrRuleParse       = errors.New("holiday rule does not follow the holiday rule format")
+	ErrObservanceParse = errors.New("holiday observance is not on-date, nearest-weekday or substitute-day")
+)
+
+const (
+	daysPerWeek = 7
+	leapYear    = 2000
+)
+
+// ruleKind is the kind of date a Rule gives.
+type ruleKind int
+
+const (
+	ruleNone ruleKind = iota
+	ruleFixed
+	ruleOnce
+	ruleNthWeekday
+	ruleWeekdayBefore
+	ruleWeekdayAfter
+	ruleEaster
+	ruleOrthodoxEaster
+)
+
+// ordinals are the words for the nth weekday of a month, with last as -1.
+var ordinals = map[string]int{ //nolint:gochecknoglobals
+	"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5, "last": -1, //nolint:mnd
+}
+
+// Rule is how the date of a holiday is found in a year. Its text forms are:
+//
+//   - a fixed date, such as January 1
+//   - a single date, such as 2018-12-05, for a holiday proclaimed once
+//   - the nth or last weekday of a month, such as third Monday of January or last Monday of May
+//   - the weekday before or after a date, such as Wednesday before November 23
+//   - a number of days from Easter Sunday or Orthodox Easter Sunday, such as Easter, Easter - 2 or Easter + 1 day
+//
+// Names are matched without regard to case. Its zero value is no rule at all and gives no dates.
+type Rule struct { //nolint:recvcheck
+	kind    ruleKind
+	year    int
+	month   time.Month
+	day     int
+	weekday time.Weekday
+	// nth is the nth weekday of the month, or -1 for the last one.
+	nth int
+	// offset is the number of days from Easter Sunday.
+	offset int
+}
+
+// ParseRule parses the text form of a holiday rule. A fixed date must be a day of its month, so February 29 is
+// valid and gives a date in leap years only, but February 30 is not.
+func ParseRule(s string) (Rule, error) {
+	fields := strings.Fields(strings.ToLower(s))
+
+	var (
+		rule Rule
+		err  error
+	)
+
+	switch {
+	case len(fields) == 0:
+		err = fmt.Errorf("%w: it is empty", ErrRuleParse)
+	case fields[0] == "easter":
+		rule, err = parseEasterRule(ruleEaster, fields[1:])
+	case len(fields) > 1 && fields[0] == "orthodox" && fields[1] == "easter":
+		rule, err = parseEasterRule(ruleOrthodoxEaster, fields[2:])
+	case len(fields) == 1:
+		rule, err = parseOnceRule(fields[0])
+	case len(fields) == 2: //nolint:mnd
+		rule, err = parseFixedRule(fields[0], fields[1])
+	case len(fields) == 4 && fields[2] == "of": //nolint:mnd
+		rule, err = parseNthWeekdayRule(fields)
+	case len(fields) == 4 && (fields[1] == "before" || fields[1] == "after"): //nolint:mnd
+		rule, err = parseRelativeRule(fields)
+	default:
+		err = fmt.Errorf("%w: it is not a date, weekday or Easter rule", ErrRuleParse)
+	}
+
+	if err != nil {
+		return Rule{}, fmt.Errorf("ParseRule %q: %w", s, err)
+	}
+
+	return rule, nil
+}
+
+// IsZero reports whether r is the zero Rule.
+func (r Rule) IsZero() bool {
+	return r.kind == ruleNone
+}
+
+// Date returns the date the rule gives in the year, or false when it gives none, such as for a single date in
+// another year, February 29 in a common year or the fifth Monday of a month with four.
+func (r Rule) Date(year int) (dte.Date, bool) {
+	var date time.Time
+
+	switch r.kind {
+	case ruleNone:
+		return dte.Date{}, false
+	case ruleFixed:
+		date = time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+	case ruleOnce:
+		if year != r.year {
+			return dte.Date{}, false
+		}
+
+		date = time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+	case ruleNthWeekday:
+		date = nthWeekday(year, r.month, r.weekday, r.nth)
+	case ruleWeekdayBefore:
+		anchor := time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+		date = anchor.AddDate(0, 0, -((int(anchor.Weekday())-int(r.weekday)+daysPerWeek-1)%daysPerWeek + 1))
+	case ruleWeekdayAfter:
+		anchor := time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+		date = anchor.AddDate(0, 0, (int(r.weekday)-int(anchor.Weekday())+daysPerWeek-1)%daysPerWeek+1)
+	case ruleEaster:
+		date = Easter(year).AddDate(0, 0, r.offset)
+	case ruleOrthodoxEaster:
+		date = OrthodoxEaster(year).AddDate(0, 0, r.offset)
+	}
+
+	// A fixed date or an nth weekday that is not in the month overflows into the next one.
+	if (r.kind == ruleFixed || r.kind == ruleNthWeekday) && date.Month() != r.month {
+		return dte.Date{}, false
+	}
+
+	return dte.Date{Time: date}, true
+}
+
+// String returns the text form of the rule, with capitalized names, or an empty string for the zero Rule.
+func (r Rule) String() string {
+	switch r.kind {
+	case ruleNone:
+		return ""
+	case ruleFixed:
+		return fmt.Sprintf("%s %d", r.month, r.day)
+	case ruleOnce:
+		return time.Date(r.year, r.month, r.day, 0, 0, 0, 0, time.UTC).Format(dte.DateOnly)
+	case ruleNthWeekday:
+		for word, nth := range ordinals {
+			if nth == r.nth {
+				return fmt.Sprintf("%s %s of %s", word, r.weekday, r.month)
+			}
+		}
+	case ruleWeekdayBefore:
+		return fmt.Sprintf("%s before %s %d", r.weekday, r.month, r.day)
+	case ruleWeekdayAfter:
+		return fmt.Sprintf("%s after %s %d", r.weekday, r.month, r.day)
+	case ruleEaster, ruleOrthodoxEaster:
+		name := "Easter"
+		if r.kind == ruleOrthodoxEaster {
+			name = "Orthodox Easter"
+		}
+
+		switch {
+		case r.offset > 0:
+			return fmt.Sprintf("%s + %d", name, r.offset)
+		case r.offset < 0:
+			return fmt.Sprintf("%s - %d", name, -r.offset)
+		default:
+			return name
+		}
+	}
+
+	return ""
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (r Rule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (r *Rule) UnmarshalText(data []byte) error {
+	parsed, err := ParseRule(string(data))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// Observance is the day a holiday that falls on the weekend is observed on instead.
+type Observance int //nolint:recvcheck
+
+const (
+	// ObserveOnDate observes a holiday on its date, even on the weekend.
+	ObserveOnDate Observance = iota
+	// ObserveNearestWeekday observes a holiday on the weekend on the nearest day that is not, so with a Saturday and
+	// Sunday weekend on the Friday before a Saturday and the Monday after a Sunday, as for US federal holidays.
+	ObserveNearestWeekday
+	// ObserveSubstituteDay observes a holiday on the weekend on the first day after it that is neither in the weekend
+	// nor the date a holiday is observed on, as for UK bank holidays.
+	ObserveSubstituteDay
+)
+
+// String returns the text form of the observance, on-date, nearest-weekday or substitute-day.
+func (o Observance) String() string {
+	switch o {
+	case ObserveOnDate:
+		return "on-date"
+	case ObserveNearestWeekday:
+		return "nearest-weekday"
+	case ObserveSubstituteDay:
+		return "substitute-day"
+	default:
+		return "Observance(" + strconv.Itoa(int(o)) + ")"
+	}
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (o Observance) MarshalText() ([]byte, error) {
+	if o < ObserveOnDate || o > ObserveSubstituteDay {
+		return nil, fmt.Errorf("%w: %d", ErrObservanceParse, o)
+	}
+
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+func (o *Observance) UnmarshalText(data []byte) error {
+	for observance := ObserveOnDate; observance <= ObserveSubstituteDay; observance++ {
+		if strings.EqualFold(string(data), observance.String()) {
+			*o = observance
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q", ErrObservanceParse, data)
+}
+
+// parseEasterRule parses the days from Easter Sunday, such as + 1 day, -2 or nothing.
+func parseEasterRule(kind ruleKind, fields []string) (Rule, error) {
+	if n := len(fields); n > 0 && (fields[n-1] == "day" || fields[n-1] == "days") {
+		fields = fields[:n-1]
+	}
+
+	rule := Rule{kind: kind}
+
+	if len(fields) == 0 {
+		return rule, nil
+	}
+
+	text := strings.Join(fields, "")
+	if text[0] != '+' && text[0] != '-' {
+		return Rule{}, fmt.Errorf("%w: days from Easter %q do not start with + or -", ErrRuleParse, text)
+	}
+
+	offset, err := strconv.Atoi(text)
+	if err != nil {
+		return Rule{}, fmt.Errorf("%w: days from Easter %q are not a number", ErrRuleParse, text)
+	}
+
+	rule.offset = offset
+
+	return rule, nil
+}
+
+// parseOnceRule parses a single date, such as 2018-12-05.
+func parseOnceRule(text string) (Rule, error) {
+	date, err := time.Parse(dte.DateOnly, text)
+	if err != nil {
+		return Rule{}, fmt.Errorf("%w: %q is not a yyyy-mm-dd date", ErrRuleParse, text)
+	}
+
+	return Rule{kind: ruleOnce, year: date.Year(), month: date.Month(), day: date.Day()}, nil
+}
+
+// parseFixedRule parses a fixed date, such as January 1.
+func parseFixedRule(monthText string, dayText string) (Rule, error) {
+	month, err := parseMonth(monthText)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	// The days of the month in a leap year, so February 29 is a day of February.
+	days := time.Date(leapYear, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	day, err := strconv.Atoi(dayText)
+	if err != nil || day < 1 || day > days {
+		return Rule{}, fmt.Errorf("%w: %q is not a day of %s", ErrRuleParse, dayText, month)
+	}
+
+	return Rule{kind: ruleFixed, month: month, day: day}, nil
+}
+
+// parseNthWeekdayRule parses the nth weekday of a month, such as third Monday of January.
+func parseNthWeekdayRule(fields []string) (Rule, error) {
+	nth, ok := ordinals[fields[0]]
+	if !ok {
+		return Rule{}, fmt.Errorf("%w: %q is not first, second, third, fourth, fifth or last", ErrRuleParse, fields[0])
+	}
+
+	weekday, err := parseWeekday(fields[1])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	month, err := parseMonth(fields[3])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return Rule{kind: ruleNthWeekday, month: month, weekday: weekday, nth: nth}, nil
+}
+
+// parseRelativeRule parses the weekday before or after a date, such as Wednesday before November 23.
+func parseRelativeRule(fields []string) (Rule, error) {
+	weekday, err := parseWeekday(fields[0])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	rule, err := parseFixedRule(fields[2], fields[3])
+	if err != nil {
+		return Rule{}, err
+	}
+
+	rule.kind = ruleWeekdayAfter
+	if fields[1] == "before" {
+		rule.kind = ruleWeekdayBefore
+	}
+
+	rule.weekday = weekday
+
+	return rule, nil
+}
+
+// parseMonth parses the English name of a month.
+func parseMonth(text string) (time.Month, error) {
+	for month := time.January; month <= time.December; month++ {
+		if strings.EqualFold(text, month.String()) {
+			return month, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q is not the name of a month", ErrRuleParse, text)
+}
+
+// parseWeekday parses the English name of a day of the week.
+func parseWeekday(text string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(text, weekday.String()) {
+			return weekday, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q is not the name of a day of the week", ErrRuleParse, text)
+}
+
+// nthWeekday returns the nth weekday of the month, or the last one for -1. The fifth weekday of a month with four
+// overflows into the next month.
+func nthWeekday(year int, month time.Month, weekday time.Weekday, nth int) time.Time {
+	if nth < 0 {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+
+		return last.AddDate(0, 0, -((int(last.Weekday()) - int(weekday) + daysPerWeek) % daysPerWeek))
+	}
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	return first.AddDate(0, 0, (int(weekday)-int(first.Weekday())+daysPerWeek)%daysPerWeek+(nth-1)*daysPerWeek)
+}
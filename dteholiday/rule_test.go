@@ -0,0 +1,161 @@
+package dteholiday_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dteholiday"
+)
+
+func ExampleParseRule() {
+	for _, text := range []string{"third Monday of January", "Easter + 1 day", "Wednesday before November 23"} {
+		rule, err := dteholiday.ParseRule(text)
+		if err != nil {
+			return
+		}
+
+		date, _ := rule.Date(2024)
+		fmt.Printf("%s: %s\n", rule, date)
+	}
+
+	// Output:
+	// third Monday of January: 2024-01-15
+	// Easter + 1: 2024-04-01
+	// Wednesday before November 23: 2024-11-20
+}
+
+func TestParseRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input  string
+		string string
+		dates  map[int]string
+	}{
+		{"January 1", "January 1", map[int]string{2024: "2024-01-01"}},
+		{"  december   25 ", "December 25", map[int]string{2023: "2023-12-25"}},
+		{"February 29", "February 29", map[int]string{2024: "2024-02-29", 2023: ""}},
+		{"2018-12-05", "2018-12-05", map[int]string{2018: "2018-12-05", 2019: ""}},
+		{"first Monday of September", "first Monday of September", map[int]string{2024: "2024-09-02"}},
+		{"fourth Thursday of November", "fourth Thursday of November", map[int]string{2024: "2024-11-28"}},
+		{"fifth Friday of March", "fifth Friday of March", map[int]string{2024: "2024-03-29", 2022: ""}},
+		{"LAST monday OF may", "last Monday of May", map[int]string{2024: "2024-05-27", 2021: "2021-05-31"}},
+		{"last Sunday of February", "last Sunday of February", map[int]string{2024: "2024-02-25"}},
+		{"Wednesday before November 23", "Wednesday before November 23", map[int]string{
+			2022: "2022-11-16", 2023: "2023-11-22", 2024: "2024-11-20",
+		}},
+		{"Monday before May 25", "Monday before May 25", map[int]string{2020: "2020-05-18", 2024: "2024-05-20"}},
+		{"Monday after May 24", "Monday after May 24", map[int]string{2020: "2020-05-25", 2021: "2021-05-31"}},
+		{"Easter", "Easter", map[int]string{2024: "2024-03-31"}},
+		{"Easter - 2", "Easter - 2", map[int]string{2024: "2024-03-29"}},
+		{"easter +39 days", "Easter + 39", map[int]string{2024: "2024-05-09"}},
+		{"Orthodox Easter + 1 day", "Orthodox Easter + 1", map[int]string{2024: "2024-05-06"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := dteholiday.ParseRule(tt.input)
+			if err != nil {
+				t.Fatalf("ParseRule() error = %v", err)
+			}
+
+			if rule.String() != tt.string {
+				t.Errorf("String() = %q, want %q", rule.String(), tt.string)
+			}
+
+			for year, want := range tt.dates {
+				date, ok := rule.Date(year)
+				if got := date.String(); ok != (want != "") || ok && got != want {
+					t.Errorf("Date(%d) = %s, %v, want %q", year, got, ok, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRuleErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"",
+		"February 30",
+		"Smarch 1",
+		"January",
+		"2018-13-05",
+		"sixth Monday of May",
+		"third Funday of May",
+		"third Monday in May",
+		"Monday before June 31",
+		"Easter 2",
+		"Easter + two",
+		"Easter + 1 week",
+		"first Monday of May please",
+	} {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := dteholiday.ParseRule(input); !errors.Is(err, dteholiday.ErrRuleParse) {
+				t.Errorf("ParseRule(%q) error = %v, want %v", input, err, dteholiday.ErrRuleParse)
+			}
+		})
+	}
+}
+
+func TestRuleZero(t *testing.T) {
+	t.Parallel()
+
+	var rule dteholiday.Rule
+
+	if !rule.IsZero() || rule.String() != "" {
+		t.Errorf("zero Rule IsZero() = %v, String() = %q", rule.IsZero(), rule.String())
+	}
+
+	if _, ok := rule.Date(2024); ok {
+		t.Errorf("zero Rule Date() = true, want false")
+	}
+}
+
+func TestHolidayJSON(t *testing.T) {
+	t.Parallel()
+
+	input := `{"name":"Spring bank holiday","rule":"last Monday of May","observance":"substitute-day",` +
+		`"from":1971,"moved":{"2022":"2022-06-02"}}`
+
+	var holiday dteholiday.Holiday
+
+	err := json.Unmarshal([]byte(input), &holiday)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if holiday.Observance != dteholiday.ObserveSubstituteDay || holiday.From != 1971 {
+		t.Errorf("Unmarshal() = %+v", holiday)
+	}
+
+	for year, want := range map[int]string{1970: "", 2021: "2021-05-31", 2022: "2022-06-02"} {
+		date, ok := holiday.Date(year)
+		if got := date.String(); ok != (want != "") || ok && got != want {
+			t.Errorf("Date(%d) = %s, %v, want %q", year, got, ok, want)
+		}
+	}
+
+	output, err := json.Marshal(holiday)
+	if err != nil || string(output) != input {
+		t.Errorf("Marshal() = %s, %v, want %s", output, err, input)
+	}
+
+	for _, bad := range []string{`{"rule":"Easter 2"}`, `{"rule":"Easter","observance":"never"}`} {
+		err := json.Unmarshal([]byte(bad), &holiday)
+		if !errors.Is(err, dteholiday.ErrRuleParse) && !errors.Is(err, dteholiday.ErrObservanceParse) {
+			t.Errorf("Unmarshal(%s) error = %v, want a parse error", bad, err)
+		}
+	}
+
+	if _, err := json.Marshal(dteholiday.Observance(7)); !errors.Is(err, dteholiday.ErrObservanceParse) {
+		t.Errorf("Marshal(Observance(7)) error = %v, want %v", err, dteholiday.ErrObservanceParse)
+	}
+}
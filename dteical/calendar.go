@@ -0,0 +1,513 @@
+package dteical
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrSyntax   = errors.New("calendar does not follow the RFC 5545 iCalendar syntax")
+	ErrValue    = errors.New("calendar property value is not valid")
+	ErrTimeZone = errors.New("calendar time zone can not be resolved")
+)
+
+// DefaultProdID is the PRODID Write uses for a calendar without one.
+const DefaultProdID = "-//peterHoburg//go-date-and-time-extension dteical//EN"
+
+// Calendar is a VCALENDAR with its time zones and events. Other components, such as VTODO, and unknown properties are
+// skipped when reading.
+type Calendar struct {
+	// ProdID identifies the product that created the calendar.
+	ProdID string
+	// Name is the X-WR-CALNAME display name of the calendar.
+	Name string
+	// TimeZones are the VTIMEZONE blocks. Write adds one for every other time zone a zoned date-time refers to.
+	TimeZones []TimeZone
+	// Events are the VEVENT blocks.
+	Events []Event
+}
+
+// Event is a VEVENT, an all-day event when its start is a date, with its recurrence.
+type Event struct {
+	// UID is the globally unique identifier of the event.
+	UID string
+	// Stamp is the DTSTAMP, when the event was created or last changed, in UTC.
+	Stamp time.Time
+	// Summary is the title of the event.
+	Summary string
+	// Description is the longer description of the event.
+	Description string
+	// Location is where the event takes place.
+	Location string
+	// Start is the DTSTART of the event.
+	Start DateTime
+	// End is the DTEND of the event, exclusive, or the zero DateTime. A DURATION is read as the end it leads to.
+	End DateTime
+	// Rules are the RRULE rules the event recurs by.
+	Rules []dte.RRule
+	// RDates are the RDATE extra occurrences of the event.
+	RDates []DateTime
+	// ExDates are the EXDATE occurrences left out of the event.
+	ExDates []DateTime
+}
+
+// component is a BEGIN and END block with its content lines and the blocks nested in it.
+type component struct {
+	name     string
+	lines    []contentLine
+	children []component
+}
+
+// AllDay reports whether the event lasts whole days, with a date as its start.
+func (e Event) AllDay() bool {
+	return e.Start.Form == FormDate
+}
+
+// Recurrence returns the recurrence set of the event, reading dates and floating date-times in the location.
+// Occurrences of zoned date-times keep their own time zone, so they follow its daylight saving time changes.
+func (e Event) Recurrence(location *time.Location) dte.Recurrence {
+	instants := func(values []DateTime) []time.Time {
+		times := make([]time.Time, 0, len(values))
+		for _, value := range values {
+			times = append(times, value.Instant(location))
+		}
+
+		return times
+	}
+
+	return dte.Recurrence{
+		Start:   e.Start.Instant(location),
+		Rules:   e.Rules,
+		RDates:  instants(e.RDates),
+		ExDates: instants(e.ExDates),
+	}
+}
+
+// Read reads a calendar from its iCalendar form. Folded lines are unfolded, TEXT values are unescaped and a TZID is
+// resolved by its VTIMEZONE, or as an IANA time zone name when the calendar has none for it.
+func Read(r io.Reader) (Calendar, error) {
+	lines, err := readContentLines(r)
+	if err != nil {
+		return Calendar{}, err
+	}
+
+	components, err := nestComponents(lines)
+	if err != nil {
+		return Calendar{}, err
+	}
+
+	index := slices.IndexFunc(components, func(c component) bool { return c.name == "VCALENDAR" })
+	if index < 0 {
+		return Calendar{}, fmt.Errorf("%w: there is no VCALENDAR", ErrSyntax)
+	}
+
+	root := components[index]
+	calendar := Calendar{}
+	zones := map[string]*time.Location{}
+
+	for _, line := range root.lines {
+		switch line.name {
+		case "PRODID":
+			calendar.ProdID = unescapeText(line.value)
+		case "X-WR-CALNAME":
+			calendar.Name = unescapeText(line.value)
+		}
+	}
+
+	for _, child := range root.children {
+		if child.name != "VTIMEZONE" {
+			continue
+		}
+
+		zone, err := parseTimeZone(child)
+		if err != nil {
+			return Calendar{}, err
+		}
+
+		zones[zone.TZID], err = zone.Location()
+		if err != nil {
+			return Calendar{}, err
+		}
+
+		calendar.TimeZones = append(calendar.TimeZones, zone)
+	}
+
+	for _, child := range root.children {
+		if child.name != "VEVENT" {
+			continue
+		}
+
+		event, err := parseEvent(child, zones)
+		if err != nil {
+			return Calendar{}, fmt.Errorf("VEVENT %d: %w", len(calendar.Events)+1, err)
+		}
+
+		calendar.Events = append(calendar.Events, event)
+	}
+
+	return calendar, nil
+}
+
+// Write writes the calendar in its iCalendar form, with lines folded at 75 octets and ending in CRLF. A VTIMEZONE
+// covering the years of its date-times is added for every zoned TZID without one in TimeZones.
+func Write(w io.Writer, calendar Calendar) error {
+	zones := slices.Clone(calendar.TimeZones)
+
+	for tzid, years := range zonedYears(calendar.Events) {
+		if !slices.ContainsFunc(zones, func(zone TimeZone) bool { return zone.TZID == tzid }) {
+			zones = append(zones, TimeZoneOf(years.location, years.from, years.to))
+		}
+	}
+
+	slices.SortStableFunc(zones, func(a TimeZone, b TimeZone) int { return strings.Compare(a.TZID, b.TZID) })
+
+	prodID := calendar.ProdID
+	if prodID == "" {
+		prodID = DefaultProdID
+	}
+
+	out := &lineWriter{w: w, err: nil}
+
+	out.line("BEGIN:VCALENDAR")
+	out.line("VERSION:2.0")
+	out.property("PRODID", prodID)
+	out.property("X-WR-CALNAME", calendar.Name)
+
+	for _, zone := range zones {
+		writeTimeZone(out, zone)
+	}
+
+	for i, event := range calendar.Events {
+		if event.Start.IsZero() {
+			return fmt.Errorf("%w: event %d has no start", ErrValue, i+1)
+		}
+
+		writeEvent(out, event)
+	}
+
+	out.line("END:VCALENDAR")
+
+	return out.err
+}
+
+// nestComponents groups the content lines into the components their BEGIN and END lines enclose.
+func nestComponents(lines []contentLine) ([]component, error) {
+	stack := []component{{name: "", lines: nil, children: nil}}
+
+	for _, line := range lines {
+		switch line.name {
+		case "BEGIN":
+			stack = append(stack, component{name: strings.ToUpper(line.value), lines: nil, children: nil})
+		case "END":
+			last := stack[len(stack)-1]
+			if len(stack) == 1 || !strings.EqualFold(line.value, last.name) {
+				return nil, fmt.Errorf("%w: END:%s does not close BEGIN:%s", ErrSyntax, line.value, last.name)
+			}
+
+			stack = stack[:len(stack)-1]
+			stack[len(stack)-1].children = append(stack[len(stack)-1].children, last)
+		default:
+			stack[len(stack)-1].lines = append(stack[len(stack)-1].lines, line)
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%w: BEGIN:%s is not closed", ErrSyntax, stack[len(stack)-1].name)
+	}
+
+	return stack[0].children, nil
+}
+
+func parseTimeZone(c component) (TimeZone, error) {
+	zone := TimeZone{}
+
+	for _, line := range c.lines {
+		if line.name == "TZID" {
+			zone.TZID = line.value
+		}
+	}
+
+	if zone.TZID == "" {
+		return TimeZone{}, fmt.Errorf("%w: VTIMEZONE has no TZID", ErrSyntax)
+	}
+
+	for _, child := range c.children {
+		if child.name != "STANDARD" && child.name != "DAYLIGHT" {
+			continue
+		}
+
+		observance, err := parseObservance(child)
+		if err != nil {
+			return TimeZone{}, fmt.Errorf("VTIMEZONE %s: %w", zone.TZID, err)
+		}
+
+		zone.Observances = append(zone.Observances, observance)
+	}
+
+	return zone, nil
+}
+
+func parseObservance(c component) (Observance, error) {
+	observance := Observance{Daylight: c.name == "DAYLIGHT"}
+	utc := map[string]*time.Location{}
+
+	var err error
+
+	for _, line := range c.lines {
+		switch line.name {
+		case "DTSTART":
+			var start DateTime
+
+			start, err = parseDateTime(line.value, line, utc)
+			observance.Start = start.Time
+		case "TZOFFSETFROM":
+			observance.OffsetFrom, err = parseOffset(line.value)
+		case "TZOFFSETTO":
+			observance.OffsetTo, err = parseOffset(line.value)
+		case "TZNAME":
+			observance.Name = unescapeText(line.value)
+		case "RRULE":
+			observance.Rule, err = dte.ParseRRule(line.value)
+		case "RDATE":
+			var rdates []DateTime
+
+			rdates, err = parseDateTimeList(line, utc)
+			for _, rdate := range rdates {
+				observance.RDates = append(observance.RDates, rdate.Time)
+			}
+		}
+
+		if err != nil {
+			return Observance{}, fmt.Errorf("%w: %s: %w", ErrValue, line.name, err)
+		}
+	}
+
+	if observance.Start.IsZero() {
+		return Observance{}, fmt.Errorf("%w: %s has no DTSTART", ErrValue, c.name)
+	}
+
+	return observance, nil
+}
+
+//nolint:cyclop
+func parseEvent(c component, zones map[string]*time.Location) (Event, error) {
+	event := Event{}
+
+	var duration string
+
+	for _, line := range c.lines {
+		err := resolveZone(line, zones)
+		if err != nil {
+			return Event{}, err
+		}
+
+		switch line.name {
+		case "UID":
+			event.UID = unescapeText(line.value)
+		case "DTSTAMP":
+			var stamp DateTime
+
+			stamp, err = parseDateTime(line.value, line, zones)
+			event.Stamp = stamp.Time
+		case "SUMMARY":
+			event.Summary = unescapeText(line.value)
+		case "DESCRIPTION":
+			event.Description = unescapeText(line.value)
+		case "LOCATION":
+			event.Location = unescapeText(line.value)
+		case "DTSTART":
+			event.Start, err = parseDateTime(line.value, line, zones)
+		case "DTEND":
+			event.End, err = parseDateTime(line.value, line, zones)
+		case "DURATION":
+			duration = line.value
+		case "RRULE":
+			var rule dte.RRule
+
+			rule, err = dte.ParseRRule(line.value)
+			event.Rules = append(event.Rules, rule)
+		case "RDATE":
+			var rdates []DateTime
+
+			rdates, err = parseDateTimeList(line, zones)
+			event.RDates = append(event.RDates, rdates...)
+		case "EXDATE":
+			var exdates []DateTime
+
+			exdates, err = parseDateTimeList(line, zones)
+			event.ExDates = append(event.ExDates, exdates...)
+		}
+
+		if err != nil {
+			return Event{}, fmt.Errorf("%w: %s: %w", ErrValue, line.name, err)
+		}
+	}
+
+	if event.Start.IsZero() {
+		return Event{}, fmt.Errorf("%w: there is no DTSTART", ErrValue)
+	}
+
+	if duration != "" && event.End.IsZero() {
+		end, err := endAfter(event.Start, duration)
+		if err != nil {
+			return Event{}, err
+		}
+
+		event.End = end
+	}
+
+	return event, nil
+}
+
+// resolveZone adds the location of the TZID parameter of the line to the zones, loading it as an IANA time zone name
+// when the calendar has no VTIMEZONE for it.
+func resolveZone(line contentLine, zones map[string]*time.Location) error {
+	tzid, ok := line.params["TZID"]
+	if !ok || zones[tzid] != nil {
+		return nil
+	}
+
+	location, err := time.LoadLocation(tzid)
+	if err != nil || tzid == "" {
+		return fmt.Errorf("%w: %s TZID %q has no VTIMEZONE and is not an IANA name", ErrTimeZone, line.name, tzid)
+	}
+
+	zones[tzid] = location
+
+	return nil
+}
+
+// endAfter returns the end of an event that lasts the DURATION from its start. Days and weeks are added to the wall
+// clock, so a day is 23 or 25 hours long across a daylight saving time change, and hours, minutes and seconds as
+// elapsed time.
+func endAfter(start DateTime, value string) (DateTime, error) {
+	datePart, timePart, _ := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(value, "+"), "P"), "T")
+
+	var days, elapsed dte.Duration
+
+	var err error
+
+	if datePart != "" {
+		days, err = dte.NewDuration("P" + datePart)
+	}
+
+	if err == nil && timePart != "" {
+		elapsed, err = dte.NewDuration("PT" + timePart)
+	}
+
+	if err != nil || !strings.HasPrefix(strings.TrimPrefix(value, "+"), "P") || datePart == "" && timePart == "" {
+		return DateTime{}, fmt.Errorf("%w: DURATION %q is not a positive duration", ErrValue, value)
+	}
+
+	end := start
+	end.Time = start.Time.AddDate(0, 0, int(days.Duration/(24*time.Hour))).Add(elapsed.Duration) //nolint:mnd
+
+	return end, nil
+}
+
+// zoneYears is the location of a TZID and the years its date-times fall in.
+type zoneYears struct {
+	location *time.Location
+	from     int
+	to       int
+}
+
+// zonedYears returns the years the zoned date-times of the events fall in by TZID.
+func zonedYears(events []Event) map[string]zoneYears {
+	years := map[string]zoneYears{}
+
+	for _, event := range events {
+		values := append([]DateTime{event.Start, event.End}, event.RDates...)
+		values = append(values, event.ExDates...)
+
+		for _, value := range values {
+			if value.Form != FormZoned {
+				continue
+			}
+
+			year := value.Time.Year()
+
+			current, ok := years[value.TZID()]
+			if !ok {
+				current = zoneYears{location: value.Time.Location(), from: year, to: year}
+			}
+
+			current.from, current.to = min(current.from, year), max(current.to, year)
+			years[value.TZID()] = current
+		}
+	}
+
+	return years
+}
+
+func writeTimeZone(out *lineWriter, zone TimeZone) {
+	out.line("BEGIN:VTIMEZONE")
+	out.line("TZID:" + zone.TZID)
+
+	for _, observance := range zone.Observances {
+		block := "STANDARD"
+		if observance.Daylight {
+			block = "DAYLIGHT"
+		}
+
+		out.line("BEGIN:" + block)
+		out.line("DTSTART:" + observance.Start.Format(dateTimeLayout))
+		out.line("TZOFFSETFROM:" + formatOffset(observance.OffsetFrom))
+		out.line("TZOFFSETTO:" + formatOffset(observance.OffsetTo))
+		out.property("TZNAME", observance.Name)
+
+		if !observance.Rule.IsZero() {
+			out.line("RRULE:" + observance.Rule.String())
+		}
+
+		for _, rdate := range observance.RDates {
+			out.line("RDATE:" + rdate.Format(dateTimeLayout))
+		}
+
+		out.line("END:" + block)
+	}
+
+	out.line("END:VTIMEZONE")
+}
+
+func writeEvent(out *lineWriter, event Event) {
+	out.line("BEGIN:VEVENT")
+	out.property("UID", event.UID)
+
+	if !event.Stamp.IsZero() {
+		out.line("DTSTAMP:" + UTC(event.Stamp).String())
+	}
+
+	writeDateTime(out, "DTSTART", event.Start)
+	writeDateTime(out, "DTEND", event.End)
+
+	for _, rule := range event.Rules {
+		out.line("RRULE:" + rule.String())
+	}
+
+	for _, rdate := range event.RDates {
+		writeDateTime(out, "RDATE", rdate)
+	}
+
+	for _, exdate := range event.ExDates {
+		writeDateTime(out, "EXDATE", exdate)
+	}
+
+	out.property("SUMMARY", event.Summary)
+	out.property("DESCRIPTION", event.Description)
+	out.property("LOCATION", event.Location)
+	out.line("END:VEVENT")
+}
+
+// writeDateTime writes a DATE or DATE-TIME property with its VALUE or TZID parameter, leaving out a zero value.
+func writeDateTime(out *lineWriter, name string, value DateTime) {
+	if !value.IsZero() {
+		out.line(name + value.params() + ":" + value.String())
+	}
+}
@@ -0,0 +1,303 @@
+package dteical_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteical"
+)
+
+func ExampleRead() {
+	input := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//Microsoft Corporation//Outlook 16.0 MIMEDIR//EN\r\n" +
+		pacificTimeZone +
+		"BEGIN:VEVENT\r\n" +
+		"UID:standup@example.com\r\n" +
+		"DTSTART;TZID=Pacific Standard Time:20240307T090000\r\n" +
+		"DURATION:PT15M\r\n" +
+		"RRULE:FREQ=WEEKLY;BYDAY=TH;COUNT=3\r\n" +
+		"EXDATE;TZID=Pacific Standard Time:20240314T090000\r\n" +
+		"SUMMARY:Standup\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	calendar, err := dteical.Read(strings.NewReader(input))
+	if err != nil {
+		return
+	}
+
+	event := calendar.Events[0]
+	fmt.Println(event.Summary, event.Start.TZID(), event.End.Time.Sub(event.Start.Time))
+
+	for occurrence := range event.Recurrence(time.UTC).Occurrences() {
+		fmt.Println(occurrence.UTC())
+	}
+
+	// Output:
+	// Standup Pacific Standard Time 15m0s
+	// 2024-03-07 17:00:00 +0000 UTC
+	// 2024-03-21 16:00:00 +0000 UTC
+}
+
+func ExampleWrite() {
+	date, err := dte.NewDate("2024-12-25")
+	if err != nil {
+		return
+	}
+
+	yearly, err := dte.ParseRRule("FREQ=YEARLY")
+	if err != nil {
+		return
+	}
+
+	calendar := dteical.Calendar{
+		ProdID:    "-//example//holidays//EN",
+		Name:      "Holidays",
+		TimeZones: nil,
+		Events: []dteical.Event{{
+			UID:     "christmas@example.com",
+			Summary: "Christmas Day",
+			Start:   dteical.AllDay(date),
+			End:     dteical.AllDay(dte.Date{Time: date.AddDate(0, 0, 1)}),
+			Rules:   []dte.RRule{yearly},
+		}},
+	}
+
+	var out strings.Builder
+
+	err = dteical.Write(&out, calendar)
+	if err != nil {
+		return
+	}
+
+	// Lines end in CRLF, which the example output leaves out.
+	fmt.Print(strings.ReplaceAll(out.String(), "\r\n", "\n"))
+
+	// Output:
+	// BEGIN:VCALENDAR
+	// VERSION:2.0
+	// PRODID:-//example//holidays//EN
+	// X-WR-CALNAME:Holidays
+	// BEGIN:VEVENT
+	// UID:christmas@example.com
+	// DTSTART;VALUE=DATE:20241225
+	// DTEND;VALUE=DATE:20241226
+	// RRULE:FREQ=YEARLY
+	// SUMMARY:Christmas Day
+	// END:VEVENT
+	// END:VCALENDAR
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	berlin := mustLoad(t, "Europe/Berlin")
+	rule, err := dte.ParseRRule("FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20241231T230000Z")
+	if err != nil {
+		t.Fatalf("ParseRRule() error = %v", err)
+	}
+
+	want := dteical.Calendar{
+		ProdID:    "-//test//EN",
+		Name:      "Team; events, all",
+		TimeZones: nil,
+		Events: []dteical.Event{
+			{
+				UID:         "review@example.com",
+				Stamp:       time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC),
+				Summary:     "Review",
+				Description: "Line one\nLine two",
+				Location:    "Room 1",
+				Start:       dteical.Zoned(time.Date(2024, time.January, 26, 16, 0, 0, 0, berlin)),
+				End:         dteical.Zoned(time.Date(2024, time.January, 26, 17, 0, 0, 0, berlin)),
+				Rules:       []dte.RRule{rule},
+				RDates:      []dteical.DateTime{dteical.UTC(time.Date(2024, time.July, 1, 14, 0, 0, 0, time.UTC))},
+				ExDates:     []dteical.DateTime{dteical.Zoned(time.Date(2024, time.March, 29, 16, 0, 0, 0, berlin))},
+			},
+			{
+				UID:         "holiday@example.com",
+				Stamp:       time.Time{},
+				Summary:     "Holiday",
+				Description: "",
+				Location:    "",
+				Start:       dteical.AllDay(dte.Date{Time: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)}),
+				End:         dteical.DateTime{},
+				Rules:       nil,
+				RDates:      nil,
+				ExDates:     nil,
+			},
+			{
+				UID:         "wakeup@example.com",
+				Stamp:       time.Time{},
+				Summary:     "Wake up",
+				Description: "",
+				Location:    "",
+				Start:       dteical.Floating(time.Date(2024, time.May, 2, 7, 0, 0, 0, time.UTC)),
+				End:         dteical.Floating(time.Date(2024, time.May, 2, 7, 5, 0, 0, time.UTC)),
+				Rules:       nil,
+				RDates:      nil,
+				ExDates:     nil,
+			},
+		},
+	}
+
+	var out strings.Builder
+
+	err = dteical.Write(&out, want)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := dteical.Read(strings.NewReader(out.String()))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	if got.ProdID != want.ProdID || got.Name != want.Name || len(got.Events) != len(want.Events) {
+		t.Fatalf("Read() = %+v, want %+v", got, want)
+	}
+
+	if len(got.TimeZones) != 1 || got.TimeZones[0].TZID != "Europe/Berlin" {
+		t.Errorf("Read() time zones = %+v, want Europe/Berlin", got.TimeZones)
+	}
+
+	for i, event := range got.Events {
+		wantEvent := want.Events[i]
+
+		if event.UID != wantEvent.UID || !event.Stamp.Equal(wantEvent.Stamp) || event.Summary != wantEvent.Summary ||
+			event.Description != wantEvent.Description || event.Location != wantEvent.Location {
+			t.Errorf("Read() event %d = %+v, want %+v", i, event, wantEvent)
+		}
+
+		values := func(event dteical.Event) string {
+			text := fmt.Sprint(event.Start.TZID(), event.Start, event.End.TZID(), event.End, event.Rules)
+			for _, value := range append(event.RDates, event.ExDates...) {
+				text += " " + value.TZID() + value.String()
+			}
+
+			return text
+		}
+
+		if values(event) != values(wantEvent) || event.AllDay() != wantEvent.AllDay() {
+			t.Errorf("Read() event %d = %s, want %s", i, values(event), values(wantEvent))
+		}
+	}
+
+	var occurrences []string
+	for occurrence := range got.Events[0].Recurrence(time.UTC).Occurrences() {
+		occurrences = append(occurrences, occurrence.Format(time.RFC3339))
+	}
+
+	if len(occurrences) != 12 || occurrences[2] != "2024-04-26T16:00:00+02:00" ||
+		occurrences[5] != "2024-07-01T14:00:00Z" || occurrences[11] != "2024-12-27T16:00:00+01:00" {
+		t.Errorf("Occurrences() = %v", occurrences)
+	}
+}
+
+func TestReadEvents(t *testing.T) {
+	t.Parallel()
+
+	input := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VTODO\r\nUID:todo\r\nEND:VTODO\r\n" +
+		"BEGIN:VEVENT\r\nUID:days\r\nDTSTART;VALUE=DATE:20240310\r\nDURATION:P1W\r\n" +
+		"BEGIN:VALARM\r\nACTION:DISPLAY\r\nTRIGGER:-PT15M\r\nEND:VALARM\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:nominal\r\nDTSTART;TZID=America/New_York:20240309T120000\r\nDURATION:P1DT2H\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:exact\r\nDTSTART;TZID=America/New_York:20240309T120000\r\nDURATION:PT26H\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:end wins\r\nDTSTART:20240309T120000Z\r\nDTEND:20240309T130000Z\r\nDURATION:PT5H\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	calendar, err := dteical.Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	want := []string{
+		"days 20240310 20240317",
+		"nominal 20240309T120000 20240310T140000",
+		"exact 20240309T120000 20240310T150000",
+		"end wins 20240309T120000Z 20240309T130000Z",
+	}
+
+	if len(calendar.Events) != len(want) {
+		t.Fatalf("Read() events = %d, want %d", len(calendar.Events), len(want))
+	}
+
+	for i, event := range calendar.Events {
+		if got := fmt.Sprint(event.UID, " ", event.Start, " ", event.End); got != want[i] {
+			t.Errorf("Read() event %d = %s, want %s", i, got, want[i])
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{name: "no start", input: "BEGIN:VEVENT\r\nUID:a\r\nEND:VEVENT\r\n", wantErr: dteical.ErrValue},
+		{
+			name:    "invalid rule",
+			input:   "BEGIN:VEVENT\r\nDTSTART:20240101\r\nRRULE:FREQ=SOMETIMES\r\nEND:VEVENT\r\n",
+			wantErr: dteical.ErrValue,
+		},
+		{
+			name:    "invalid duration",
+			input:   "BEGIN:VEVENT\r\nDTSTART:20240101\r\nDURATION:P1M\r\nEND:VEVENT\r\n",
+			wantErr: dteical.ErrValue,
+		},
+		{
+			name:    "negative duration",
+			input:   "BEGIN:VEVENT\r\nDTSTART:20240101\r\nDURATION:-P1D\r\nEND:VEVENT\r\n",
+			wantErr: dteical.ErrValue,
+		},
+		{name: "time zone without id", input: "BEGIN:VTIMEZONE\r\nEND:VTIMEZONE\r\n", wantErr: dteical.ErrSyntax},
+		{
+			name:    "time zone without observances",
+			input:   "BEGIN:VTIMEZONE\r\nTZID:Nowhere\r\nEND:VTIMEZONE\r\n",
+			wantErr: dteical.ErrTimeZone,
+		},
+		{
+			name:    "invalid offset",
+			input:   "BEGIN:VTIMEZONE\r\nTZID:Nowhere\r\nBEGIN:STANDARD\r\nTZOFFSETTO:0100\r\nEND:STANDARD\r\nEND:VTIMEZONE\r\n",
+			wantErr: dteical.ErrValue,
+		},
+		{
+			name: "observance without start",
+			input: "BEGIN:VTIMEZONE\r\nTZID:Nowhere\r\n" +
+				"BEGIN:STANDARD\r\nTZOFFSETTO:+0100\r\nEND:STANDARD\r\nEND:VTIMEZONE\r\n",
+			wantErr: dteical.ErrValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dteical.Read(strings.NewReader("BEGIN:VCALENDAR\r\n" + tt.input + "END:VCALENDAR\r\n"))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Read() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	t.Parallel()
+
+	calendar := dteical.Calendar{ProdID: "", Name: "", TimeZones: nil, Events: []dteical.Event{{UID: "no start"}}}
+
+	err := dteical.Write(&strings.Builder{}, calendar)
+	if !errors.Is(err, dteical.ErrValue) {
+		t.Errorf("Write() error = %v, wantErr %v", err, dteical.ErrValue)
+	}
+}
@@ -0,0 +1,168 @@
+package dteical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the length RFC 5545 folds content lines at, not counting the line break.
+const maxLineOctets = 75
+
+// contentLine is an RFC 5545 content line, NAME;PARAM=value:value, with the parameter names upper cased and the
+// quotes around parameter values removed.
+type contentLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// readContentLines returns the unfolded content lines of r. Lines may end in CRLF or LF, and a line starting with a
+// space or tab continues the one before it.
+func readContentLines(r io.Reader) ([]contentLine, error) {
+	scanner := bufio.NewScanner(r)
+
+	var unfolded []string
+
+	for scanner.Scan() {
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+
+		switch {
+		case text == "":
+			continue
+		case text[0] == ' ' || text[0] == '\t':
+			if len(unfolded) == 0 {
+				return nil, fmt.Errorf("%w: the first line is a continuation", ErrSyntax)
+			}
+
+			unfolded[len(unfolded)-1] += text[1:]
+		default:
+			unfolded = append(unfolded, text)
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("reading calendar: %w", err)
+	}
+
+	lines := make([]contentLine, 0, len(unfolded))
+
+	for i, text := range unfolded {
+		line, err := parseContentLine(text)
+		if err != nil {
+			return nil, fmt.Errorf("content line %d: %w", i+1, err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// parseContentLine splits a content line into its name, parameters and value. Parameter values may be quoted to hold
+// the ;, : and , characters.
+func parseContentLine(text string) (contentLine, error) {
+	line := contentLine{params: map[string]string{}}
+
+	end := strings.IndexAny(text, ";:")
+	if end <= 0 {
+		return contentLine{}, fmt.Errorf("%w: %q has no name and value", ErrSyntax, text)
+	}
+
+	line.name = strings.ToUpper(text[:end])
+	rest := text[end:]
+
+	for strings.HasPrefix(rest, ";") {
+		name, value, ok := strings.Cut(rest[1:], "=")
+		if !ok {
+			return contentLine{}, fmt.Errorf("%w: %q has a parameter without a value", ErrSyntax, text)
+		}
+
+		rest = value
+		value = ""
+
+		for {
+			if strings.HasPrefix(rest, `"`) {
+				quoted, after, ok := strings.Cut(rest[1:], `"`)
+				if !ok {
+					return contentLine{}, fmt.Errorf("%w: %q has an unterminated quote", ErrSyntax, text)
+				}
+
+				value, rest = value+quoted, after
+			} else {
+				i := strings.IndexAny(rest, ",;:")
+				if i < 0 {
+					return contentLine{}, fmt.Errorf("%w: %q has no value", ErrSyntax, text)
+				}
+
+				value, rest = value+rest[:i], rest[i:]
+			}
+
+			if !strings.HasPrefix(rest, ",") {
+				break
+			}
+
+			value, rest = value+",", rest[1:]
+		}
+
+		line.params[strings.ToUpper(name)] = value
+	}
+
+	value, ok := strings.CutPrefix(rest, ":")
+	if !ok {
+		return contentLine{}, fmt.Errorf("%w: %q has no value", ErrSyntax, text)
+	}
+
+	line.value = value
+
+	return line, nil
+}
+
+// unescapeText returns the TEXT value with its \\, \;, \, and \n escapes replaced.
+func unescapeText(value string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n").Replace(value)
+}
+
+// escapeText returns the TEXT value with backslashes, semicolons, commas and line breaks escaped.
+func escapeText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
+
+// lineWriter writes folded content lines ending in CRLF and keeps the first error.
+type lineWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes the content line, folding it into lines of at most 75 octets without splitting a UTF-8 sequence.
+func (w *lineWriter) line(text string) {
+	if w.err != nil {
+		return
+	}
+
+	var b strings.Builder
+
+	for limit := maxLineOctets; len(text) > limit; limit = maxLineOctets - len(" ") {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+
+		b.WriteString(text[:cut] + "\r\n ")
+		text = text[cut:]
+	}
+
+	b.WriteString(text + "\r\n")
+
+	_, w.err = io.WriteString(w.w, b.String())
+}
+
+// property writes a property with an escaped TEXT value, leaving it out when the value is empty.
+func (w *lineWriter) property(name string, value string) {
+	if value != "" {
+		w.line(name + ":" + escapeText(value))
+	}
+}
@@ -0,0 +1,123 @@
+package dteical_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteical"
+)
+
+func TestWriteFoldsLines(t *testing.T) {
+	t.Parallel()
+
+	description := strings.Repeat("Quarterly planning – agenda, notes; and owners. ", 6) + "\\done\nnext line"
+	calendar := dteical.Calendar{
+		ProdID: "",
+		Name:   "",
+		Events: []dteical.Event{{
+			UID:         "fold@example.com",
+			Summary:     "Planning",
+			Description: description,
+			Start:       dteical.AllDay(dte.Date{Time: mustParse(t, "2024-01-02T00:00:00Z")}),
+		}},
+		TimeZones: nil,
+	}
+
+	var out bytes.Buffer
+
+	err := dteical.Write(&out, calendar)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	text := out.String()
+	if !strings.HasSuffix(text, "\r\n") || strings.Contains(strings.ReplaceAll(text, "\r\n", ""), "\n") {
+		t.Fatalf("Write() lines do not all end in CRLF:\n%q", text)
+	}
+
+	folded := 0
+
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line is %d octets long: %q", len(line), line)
+		}
+
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a UTF-8 sequence: %q", line)
+		}
+
+		if strings.HasPrefix(line, " ") {
+			folded++
+		}
+	}
+
+	if folded < 3 {
+		t.Errorf("Write() folded %d lines, want at least 3", folded)
+	}
+
+	read, err := dteical.Read(&out)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	if got := read.Events[0].Description; got != description {
+		t.Errorf("Read() description = %q, want %q", got, description)
+	}
+}
+
+func TestReadUnfoldsLines(t *testing.T) {
+	t.Parallel()
+
+	// Lines end in LF only and continue with a space or a tab, as some producers write them.
+	input := "BEGIN:VCALENDAR\nVERSION:2.0\nBEGIN:VEVENT\nUID:unfold\nDTSTART;VALUE=DATE:2024\n 0102\n" +
+		"SUMMARY:Team \n\tlunch\\; bring\\, share\\N\\\\ snacks\nX-UNKNOWN;X-PARAM=\"a;b:c\":ignored\n" +
+		"END:VEVENT\nEND:VCALENDAR\n"
+
+	calendar, err := dteical.Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	event := calendar.Events[0]
+
+	if want := "Team lunch; bring, share\n\\ snacks"; event.Summary != want {
+		t.Errorf("Read() summary = %q, want %q", event.Summary, want)
+	}
+
+	if got := event.Start.String(); got != "20240102" {
+		t.Errorf("Read() start = %s, want 20240102", got)
+	}
+}
+
+func TestReadSyntaxErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "continuation first", input: " BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"},
+		{name: "no value", input: "BEGIN:VCALENDAR\r\nVERSION\r\nEND:VCALENDAR\r\n"},
+		{name: "no name", input: "BEGIN:VCALENDAR\r\n:2.0\r\nEND:VCALENDAR\r\n"},
+		{name: "parameter without value", input: "BEGIN:VCALENDAR\r\nX-A;B:c\r\nEND:VCALENDAR\r\n"},
+		{name: "unterminated quote", input: "BEGIN:VCALENDAR\r\nX-A;B=\"c:d\r\nEND:VCALENDAR\r\n"},
+		{name: "unclosed component", input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VCALENDAR\r\n"},
+		{name: "unopened component", input: "END:VCALENDAR\r\n"},
+		{name: "no calendar", input: "BEGIN:VCARD\r\nEND:VCARD\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dteical.Read(strings.NewReader(tt.input))
+			if !errors.Is(err, dteical.ErrSyntax) {
+				t.Errorf("Read() error = %v, wantErr %v", err, dteical.ErrSyntax)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package dteical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+// DateTimeForm is the form of an iCalendar DATE or DATE-TIME value.
+type DateTimeForm int
+
+const (
+	// FormDate is a VALUE=DATE date of an all-day event, such as 20240101.
+	FormDate DateTimeForm = iota + 1
+	// FormFloating is a date-time without a time zone, such as 20240101T090000, that happens at the same wall clock
+	// time wherever it is read.
+	FormFloating
+	// FormUTC is a date-time in UTC, such as 20240101T090000Z.
+	FormUTC
+	// FormZoned is a date-time with a TZID parameter, such as TZID=Europe/Berlin:20240101T090000.
+	FormZoned
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+)
+
+// DateTime is the value of an iCalendar DATE or DATE-TIME property such as DTSTART. Its zero value is no value at
+// all.
+type DateTime struct {
+	// Form is the form of the value.
+	Form DateTimeForm
+	// Time is midnight UTC of a date, the wall clock of a floating date-time in UTC, a UTC date-time, or a zoned
+	// date-time in the location of its TZID.
+	Time time.Time
+}
+
+// AllDay returns the date of an all-day event.
+func AllDay(d dte.Date) DateTime {
+	year, month, day := d.Date()
+
+	return DateTime{Form: FormDate, Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// Floating returns the wall clock of t as a floating date-time.
+func Floating(t time.Time) DateTime {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	return DateTime{Form: FormFloating, Time: time.Date(year, month, day, hour, minute, second, 0, time.UTC)}
+}
+
+// UTC returns t as a UTC date-time.
+func UTC(t time.Time) DateTime {
+	return DateTime{Form: FormUTC, Time: t.UTC().Truncate(time.Second)}
+}
+
+// Zoned returns t as a date-time whose TZID is the name of its location, such as Europe/Berlin.
+func Zoned(t time.Time) DateTime {
+	return DateTime{Form: FormZoned, Time: t.Truncate(time.Second)}
+}
+
+// IsZero reports whether v is the zero DateTime.
+func (v DateTime) IsZero() bool {
+	return v.Form == 0
+}
+
+// TZID returns the time zone identifier of a zoned date-time, or an empty string for the other forms.
+func (v DateTime) TZID() string {
+	if v.Form != FormZoned {
+		return ""
+	}
+
+	return v.Time.Location().String()
+}
+
+// Date returns the date of the value on its own wall clock.
+func (v DateTime) Date() dte.Date {
+	year, month, day := v.Time.Date()
+
+	return dte.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// Instant returns the instant of the value, reading a date as midnight and a floating date-time as its wall clock in
+// the location. UTC and zoned date-times are instants already and keep their own location.
+func (v DateTime) Instant(location *time.Location) time.Time {
+	if v.Form == FormDate || v.Form == FormFloating {
+		return inZone(v.Time, location)
+	}
+
+	return v.Time
+}
+
+// String returns the value in its iCalendar form without parameters, such as 20240101 or 20240101T090000Z.
+func (v DateTime) String() string {
+	switch v.Form {
+	case FormDate:
+		return v.Time.Format(dateLayout)
+	case FormUTC:
+		return v.Time.Format(dateTimeLayout) + "Z"
+	case FormFloating, FormZoned:
+		return v.Time.Format(dateTimeLayout)
+	default:
+		return ""
+	}
+}
+
+// params returns the VALUE and TZID parameters of the value, each with its leading semicolon.
+func (v DateTime) params() string {
+	switch v.Form {
+	case FormDate:
+		return ";VALUE=DATE"
+	case FormZoned:
+		return ";TZID=" + quoteParam(v.TZID())
+	default:
+		return ""
+	}
+}
+
+// parseDateTime parses a DATE or DATE-TIME value, reading a TZID in the location it resolves to.
+func parseDateTime(value string, line contentLine, zones map[string]*time.Location) (DateTime, error) {
+	if line.params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		parsed, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return DateTime{}, fmt.Errorf("%w: %s %q is not a date", ErrValue, line.name, value)
+		}
+
+		return DateTime{Form: FormDate, Time: parsed}, nil
+	}
+
+	if utc, ok := strings.CutSuffix(value, "Z"); ok {
+		parsed, err := time.Parse(dateTimeLayout, utc)
+		if err != nil {
+			return DateTime{}, fmt.Errorf("%w: %s %q is not a date-time", ErrValue, line.name, value)
+		}
+
+		return DateTime{Form: FormUTC, Time: parsed}, nil
+	}
+
+	location, form := time.UTC, FormFloating
+
+	if tzid, ok := line.params["TZID"]; ok {
+		location, ok = zones[tzid]
+		if !ok {
+			return DateTime{}, fmt.Errorf("%w: %s %q", ErrTimeZone, line.name, tzid)
+		}
+
+		form = FormZoned
+	}
+
+	parsed, err := time.ParseInLocation(dateTimeLayout, value, location)
+	if err != nil {
+		return DateTime{}, fmt.Errorf("%w: %s %q is not a date-time", ErrValue, line.name, value)
+	}
+
+	return DateTime{Form: form, Time: parsed}, nil
+}
+
+// parseDateTimeList parses the comma separated values of an EXDATE or RDATE line.
+func parseDateTimeList(line contentLine, zones map[string]*time.Location) ([]DateTime, error) {
+	if value := line.params["VALUE"]; value != "" && value != "DATE" && value != "DATE-TIME" {
+		return nil, fmt.Errorf("%w: %s VALUE=%s is not supported", ErrValue, line.name, value)
+	}
+
+	var values []DateTime
+
+	for _, item := range strings.Split(line.value, ",") {
+		parsed, err := parseDateTime(item, line, zones)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, parsed)
+	}
+
+	return values, nil
+}
+
+// quoteParam quotes a parameter value that holds a character that ends an unquoted one.
+func quoteParam(value string) string {
+	if strings.ContainsAny(value, ";:,") {
+		return `"` + value + `"`
+	}
+
+	return value
+}
@@ -0,0 +1,190 @@
+package dteical_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteical"
+)
+
+func mustParse(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("time.Parse() error = %v", err)
+	}
+
+	return parsed
+}
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("time.LoadLocation() error = %v", err)
+	}
+
+	return location
+}
+
+func ExampleDateTime_Instant() {
+	date, err := dte.NewDate("2024-07-04")
+	if err != nil {
+		return
+	}
+
+	allDay := dteical.AllDay(date)
+	floating := dteical.Floating(time.Date(2024, time.July, 4, 9, 30, 0, 0, time.UTC))
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(allDay, allDay.Date(), allDay.Instant(tokyo))
+	fmt.Println(floating, floating.Instant(tokyo))
+
+	// Output:
+	// 20240704 2024-07-04 2024-07-04 00:00:00 +0900 JST
+	// 20240704T093000 2024-07-04 09:30:00 +0900 JST
+}
+
+func TestDateTime(t *testing.T) {
+	t.Parallel()
+
+	berlin := mustLoad(t, "Europe/Berlin")
+	newYork := mustLoad(t, "America/New_York")
+	instant := time.Date(2024, time.March, 31, 3, 15, 45, 999, berlin)
+
+	tests := []struct {
+		name        string
+		value       dteical.DateTime
+		wantString  string
+		wantTZID    string
+		wantDate    string
+		wantInstant time.Time
+	}{
+		{
+			name:        "date",
+			value:       dteical.AllDay(dte.Date{Time: instant}),
+			wantString:  "20240331",
+			wantTZID:    "",
+			wantDate:    "2024-03-31",
+			wantInstant: time.Date(2024, time.March, 31, 0, 0, 0, 0, newYork),
+		},
+		{
+			name:        "floating",
+			value:       dteical.Floating(instant),
+			wantString:  "20240331T031545",
+			wantTZID:    "",
+			wantDate:    "2024-03-31",
+			wantInstant: time.Date(2024, time.March, 31, 3, 15, 45, 0, newYork),
+		},
+		{
+			name:        "utc",
+			value:       dteical.UTC(instant),
+			wantString:  "20240331T011545Z",
+			wantTZID:    "",
+			wantDate:    "2024-03-31",
+			wantInstant: time.Date(2024, time.March, 31, 1, 15, 45, 0, time.UTC),
+		},
+		{
+			name:        "zoned",
+			value:       dteical.Zoned(instant),
+			wantString:  "20240331T031545",
+			wantTZID:    "Europe/Berlin",
+			wantDate:    "2024-03-31",
+			wantInstant: time.Date(2024, time.March, 31, 3, 15, 45, 0, berlin),
+		},
+		{
+			name:        "zero",
+			value:       dteical.DateTime{},
+			wantString:  "",
+			wantTZID:    "",
+			wantDate:    "0001-01-01",
+			wantInstant: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.value.String(); got != tt.wantString {
+				t.Errorf("String() = %s, want %s", got, tt.wantString)
+			}
+
+			if got := tt.value.TZID(); got != tt.wantTZID {
+				t.Errorf("TZID() = %s, want %s", got, tt.wantTZID)
+			}
+
+			if got := tt.value.Date().String(); got != tt.wantDate {
+				t.Errorf("Date() = %s, want %s", got, tt.wantDate)
+			}
+
+			if tt.value.IsZero() {
+				return
+			}
+
+			if got := tt.value.Instant(newYork); !got.Equal(tt.wantInstant) || got.Location() != tt.wantInstant.Location() {
+				t.Errorf("Instant() = %v, want %v", got, tt.wantInstant)
+			}
+		})
+	}
+}
+
+func TestReadDateTimes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		property string
+		wantForm dteical.DateTimeForm
+		want     string
+		wantErr  error
+	}{
+		{name: "date", property: "DTSTART;VALUE=DATE:20240229", wantForm: dteical.FormDate, want: "20240229"},
+		{name: "date by length", property: "DTSTART:20240229", wantForm: dteical.FormDate, want: "20240229"},
+		{name: "floating", property: "DTSTART:20240229T120000", wantForm: dteical.FormFloating, want: "20240229T120000"},
+		{name: "utc", property: "DTSTART:20240229T120000Z", wantForm: dteical.FormUTC, want: "20240229T120000Z"},
+		{
+			name:     "zoned",
+			property: "DTSTART;TZID=\"America/Chicago\":20240229T120000",
+			wantForm: dteical.FormZoned,
+			want:     "America/Chicago 20240229T120000",
+		},
+		{name: "invalid date", property: "DTSTART;VALUE=DATE:20240230", wantErr: dteical.ErrValue},
+		{name: "invalid date-time", property: "DTSTART:20240229T250000", wantErr: dteical.ErrValue},
+		{name: "invalid utc", property: "DTSTART:2024-02-29T12:00Z", wantErr: dteical.ErrValue},
+		{name: "unknown zone", property: "DTSTART;TZID=Nowhere/Special:20240229T120000", wantErr: dteical.ErrTimeZone},
+		{name: "period", property: "RDATE;VALUE=PERIOD:20240229T120000Z/PT1H\r\nDTSTART:20240229", wantErr: dteical.ErrValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n" + tt.property + "\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+			calendar, err := dteical.Read(strings.NewReader(input))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Read() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			start := calendar.Events[0].Start
+			if got := strings.TrimSpace(start.TZID() + " " + start.String()); start.Form != tt.wantForm || got != tt.want {
+				t.Errorf("Read() start = %d %s, want %d %s", start.Form, got, tt.wantForm, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/peterHoburg/go-date-and-time-extension/dteical
+
+go 1.23.4
+
+require github.com/peterHoburg/go-date-and-time-extension/dte v0.2.0
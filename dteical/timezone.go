@@ -0,0 +1,299 @@
+package dteical
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+// transitionsUntilYear is the last year a time zone built from its observances has transitions in. Later times keep
+// the offset of the last transition.
+const transitionsUntilYear = 2100
+
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	hoursMinutes     = 100
+	tzifVersion      = '2'
+	tzifHeaderLength = 44
+	tzifTypeLength   = 6
+)
+
+// TimeZone is a VTIMEZONE block, the observances that give the UTC offsets of a TZID over time.
+type TimeZone struct {
+	// TZID is the identifier the DATE-TIME values of the calendar refer to.
+	TZID string
+	// Observances are the STANDARD and DAYLIGHT blocks.
+	Observances []Observance
+}
+
+// Observance is a STANDARD or DAYLIGHT block of a VTIMEZONE, a UTC offset that takes effect at a wall clock time
+// and may recur by a rule.
+type Observance struct {
+	// Daylight is true for a DAYLIGHT block and false for a STANDARD one.
+	Daylight bool
+	// Start is the wall clock time, in UTC, that the offset first takes effect at, read with OffsetFrom.
+	Start time.Time
+	// OffsetFrom is the UTC offset in seconds east of UTC before the observance takes effect.
+	OffsetFrom int
+	// OffsetTo is the UTC offset in seconds east of UTC while the observance is in effect.
+	OffsetTo int
+	// Name is the TZNAME, such as CEST, or empty.
+	Name string
+	// Rule is the RRULE the observance recurs by, or the zero RRule.
+	Rule dte.RRule
+	// RDates are the wall clock times, in UTC, of further onsets.
+	RDates []time.Time
+}
+
+// transition is the instant a UTC offset takes effect.
+type transition struct {
+	at       int64
+	offset   int
+	daylight bool
+	name     string
+}
+
+// TimeZoneOf returns a VTIMEZONE for the location with an observance of its offset at the start of the year from and
+// one for each of its offset changes from then to the end of the year to.
+func TimeZoneOf(location *time.Location, from int, to int) TimeZone {
+	zone := TimeZone{TZID: location.String(), Observances: nil}
+
+	t := time.Date(from, time.January, 1, 0, 0, 0, 0, location)
+	end := time.Date(to+1, time.January, 1, 0, 0, 0, 0, location)
+	name, offset := t.Zone()
+
+	// The first observance holds the offset at the start of the years, which is in effect until the first change.
+	zone.Observances = append(zone.Observances, Observance{
+		Daylight:   t.IsDST(),
+		Start:      wallClock(t, offset),
+		OffsetFrom: offset,
+		OffsetTo:   offset,
+		Name:       name,
+		Rule:       dte.RRule{},
+		RDates:     nil,
+	})
+
+	for t.Before(end) {
+		// Offset changes are months apart, so a day by day scan finds each one before a binary search pins it down.
+		next := t.AddDate(0, 0, 1)
+		if nextName, nextOffset := next.Zone(); nextName == name && nextOffset == offset {
+			t = next
+
+			continue
+		}
+
+		low, high := t.Unix(), next.Unix()
+		for high-low > 1 {
+			middle := low + (high-low)/2 //nolint:mnd
+
+			if middleName, middleOffset := time.Unix(middle, 0).In(location).Zone(); middleName == name &&
+				middleOffset == offset {
+				low = middle
+			} else {
+				high = middle
+			}
+		}
+
+		changed := time.Unix(high, 0).In(location)
+		nextName, nextOffset := changed.Zone()
+
+		zone.Observances = append(zone.Observances, Observance{
+			Daylight:   changed.IsDST(),
+			Start:      wallClock(changed, offset),
+			OffsetFrom: offset,
+			OffsetTo:   nextOffset,
+			Name:       nextName,
+			Rule:       dte.RRule{},
+			RDates:     nil,
+		})
+
+		name, offset, t = nextName, nextOffset, next
+	}
+
+	return zone
+}
+
+// Location returns the location of the time zone. A TZID that is an IANA time zone name, such as Europe/Berlin, is
+// loaded from the time zone database, and any other is built from the observances with transitions up to the year
+// 2100.
+func (z TimeZone) Location() (*time.Location, error) {
+	if z.TZID != "" {
+		location, err := time.LoadLocation(z.TZID)
+		if err == nil {
+			return location, nil
+		}
+	}
+
+	if len(z.Observances) == 0 {
+		return nil, fmt.Errorf("%w: %q has no observances", ErrTimeZone, z.TZID)
+	}
+
+	var transitions []transition
+
+	for _, observance := range z.Observances {
+		transitions = append(transitions, observance.transitions()...)
+	}
+
+	slices.SortFunc(transitions, func(a transition, b transition) int { return cmp.Compare(a.at, b.at) })
+	transitions = slices.CompactFunc(transitions, func(a transition, b transition) bool { return a.at == b.at })
+
+	first := slices.MinFunc(z.Observances, func(a Observance, b Observance) int { return a.Start.Compare(b.Start) })
+
+	location, err := time.LoadLocationFromTZData(z.TZID, tzif(first.OffsetFrom, transitions))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrTimeZone, z.TZID, err)
+	}
+
+	return location, nil
+}
+
+// transitions returns the onsets of the observance up to transitionsUntilYear.
+func (o Observance) transitions() []transition {
+	before := time.FixedZone("", o.OffsetFrom)
+	start := inZone(o.Start, before)
+
+	onsets := []time.Time{start}
+
+	if !o.Rule.IsZero() {
+		for onset := range o.Rule.Occurrences(start) {
+			if onset.Year() > transitionsUntilYear {
+				break
+			}
+
+			onsets = append(onsets, onset)
+		}
+	}
+
+	for _, rdate := range o.RDates {
+		onsets = append(onsets, inZone(rdate, before))
+	}
+
+	transitions := make([]transition, 0, len(onsets))
+	for _, onset := range onsets {
+		transitions = append(transitions, transition{
+			at:       onset.Unix(),
+			offset:   o.OffsetTo,
+			daylight: o.Daylight,
+			name:     o.Name,
+		})
+	}
+
+	return transitions
+}
+
+// tzif returns version 2 TZif data, as read by time.LoadLocationFromTZData, with the transitions and the initial
+// offset before them. The version 1 block is left empty since only the 64-bit block is read.
+func tzif(initial int, transitions []transition) []byte {
+	types := []transition{{at: 0, offset: initial, daylight: false, name: ""}}
+	indexes := make([]byte, 0, len(transitions))
+
+	for _, t := range transitions {
+		// Type 0 is kept for the times before the first transition only.
+		index := slices.IndexFunc(types[1:], func(other transition) bool {
+			return other.offset == t.offset && other.daylight == t.daylight && other.name == t.name
+		})
+		if index < 0 {
+			types, index = append(types, t), len(types)-1
+		}
+
+		indexes = append(indexes, byte(index+1))
+	}
+
+	var names []byte
+
+	nameIndexes := make([]int, len(types))
+
+	for i, t := range types {
+		nameIndexes[i] = len(names)
+		names = append(append(names, t.name...), 0)
+	}
+
+	data := make([]byte, 0, 2*tzifHeaderLength+len(transitions)*9+len(types)*tzifTypeLength+len(names)) //nolint:mnd
+	data = append(data, "TZif"...)
+	data = append(data, tzifVersion)
+	data = append(data, make([]byte, 15+6*4)...) //nolint:mnd
+	data = append(data, "TZif"...)
+	data = append(data, tzifVersion)
+	data = append(data, make([]byte, 15+3*4)...)                         //nolint:mnd
+	data = binary.BigEndian.AppendUint32(data, uint32(len(transitions))) //nolint:gosec
+	data = binary.BigEndian.AppendUint32(data, uint32(len(types)))       //nolint:gosec
+	data = binary.BigEndian.AppendUint32(data, uint32(len(names)))       //nolint:gosec
+
+	for _, t := range transitions {
+		data = binary.BigEndian.AppendUint64(data, uint64(t.at)) //nolint:gosec
+	}
+
+	data = append(data, indexes...)
+
+	for i, t := range types {
+		daylight := byte(0)
+		if t.daylight {
+			daylight = 1
+		}
+
+		data = binary.BigEndian.AppendUint32(data, uint32(int32(t.offset))) //nolint:gosec
+		data = append(data, daylight, byte(nameIndexes[i]))
+	}
+
+	data = append(data, names...)
+
+	return append(data, "\n\n"...)
+}
+
+// parseOffset parses a UTC offset in the ±hhmm or ±hhmmss form into seconds east of UTC.
+func parseOffset(value string) (int, error) {
+	if (len(value) != len("+hhmm") && len(value) != len("+hhmmss")) || value[0] != '+' && value[0] != '-' {
+		return 0, fmt.Errorf("%w: %q is not a UTC offset", ErrValue, value)
+	}
+
+	number, err := strconv.Atoi(value[1:])
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a UTC offset", ErrValue, value)
+	}
+
+	seconds := 0
+	if len(value) == len("+hhmmss") {
+		number, seconds = number/hoursMinutes, number%hoursMinutes
+	}
+
+	offset := number/hoursMinutes*secondsPerHour + number%hoursMinutes*secondsPerMinute + seconds
+	if value[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, nil
+}
+
+// formatOffset returns the offset in seconds east of UTC in the ±hhmm form, or ±hhmmss when it has seconds.
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+
+	text := fmt.Sprintf("%s%02d%02d", sign, offset/secondsPerHour, offset%secondsPerHour/secondsPerMinute)
+	if offset%secondsPerMinute != 0 {
+		text += fmt.Sprintf("%02d", offset%secondsPerMinute)
+	}
+
+	return text
+}
+
+// wallClock returns the wall clock of the instant at the offset, in UTC.
+func wallClock(t time.Time, offset int) time.Time {
+	return t.UTC().Add(time.Duration(offset) * time.Second)
+}
+
+// inZone returns the wall clock, given in UTC, in the location.
+func inZone(wall time.Time, location *time.Location) time.Time {
+	year, month, day := wall.Date()
+	hour, minute, second := wall.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, 0, location)
+}
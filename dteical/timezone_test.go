@@ -0,0 +1,181 @@
+package dteical_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dteical"
+)
+
+// pacificTimeZone is the VTIMEZONE Outlook writes for the Windows name of the US Pacific time zone.
+const pacificTimeZone = "BEGIN:VTIMEZONE\r\n" +
+	"TZID:Pacific Standard Time\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16010101T020000\r\n" +
+	"TZOFFSETFROM:-0700\r\n" +
+	"TZOFFSETTO:-0800\r\n" +
+	"RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11\r\n" +
+	"END:STANDARD\r\n" +
+	"BEGIN:DAYLIGHT\r\n" +
+	"DTSTART:16010101T020000\r\n" +
+	"TZOFFSETFROM:-0800\r\n" +
+	"TZOFFSETTO:-0700\r\n" +
+	"RRULE:FREQ=YEARLY;BYDAY=2SU;BYMONTH=3\r\n" +
+	"END:DAYLIGHT\r\n" +
+	"END:VTIMEZONE\r\n"
+
+func ExampleTimeZoneOf() {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		return
+	}
+
+	for _, observance := range dteical.TimeZoneOf(berlin, 2024, 2024).Observances {
+		fmt.Println(observance.Start.Format("2006-01-02 15:04"), observance.Name, observance.OffsetTo/3600)
+	}
+
+	// Output:
+	// 2024-01-01 00:00 CET 1
+	// 2024-03-31 02:00 CEST 2
+	// 2024-10-27 03:00 CET 1
+}
+
+func TestTimeZoneLocation(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := dteical.Read(strings.NewReader("BEGIN:VCALENDAR\r\n" + pacificTimeZone + "END:VCALENDAR\r\n"))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	pacific, err := calendar.TimeZones[0].Location()
+	if err != nil {
+		t.Fatalf("Location() error = %v", err)
+	}
+
+	losAngeles := mustLoad(t, "America/Los_Angeles")
+
+	// The rules have matched the IANA zone since 2007, so every hour of the years gives the same offset.
+	for at := time.Date(2007, time.January, 1, 0, 0, 0, 0, time.UTC); at.Year() < 2040; at = at.Add(time.Hour) {
+		_, got := at.In(pacific).Zone()
+		_, want := at.In(losAngeles).Zone()
+
+		if got != want {
+			t.Fatalf("offset at %v = %d, want %d", at, got, want)
+		}
+	}
+
+	if got := pacific.String(); got != "Pacific Standard Time" {
+		t.Errorf("Location() name = %s, want Pacific Standard Time", got)
+	}
+}
+
+func TestTimeZoneOfRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		from int
+		to   int
+	}{
+		{name: "America/New_York", from: 2023, to: 2025},
+		{name: "Australia/Lord_Howe", from: 2024, to: 2024},
+		{name: "Asia/Kolkata", from: 2024, to: 2026},
+		{name: "America/Sao_Paulo", from: 2018, to: 2020},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			location := mustLoad(t, tt.name)
+			zone := dteical.TimeZoneOf(location, tt.from, tt.to)
+
+			// A TZID that is not an IANA name makes Location build the zone from the observances.
+			zone.TZID = "Custom " + tt.name
+
+			custom, err := zone.Location()
+			if err != nil {
+				t.Fatalf("Location() error = %v", err)
+			}
+
+			start := time.Date(tt.from, time.January, 1, 0, 0, 0, 0, location)
+			end := time.Date(tt.to+1, time.January, 1, 0, 0, 0, 0, location)
+
+			for at := start; at.Before(end); at = at.Add(30 * time.Minute) {
+				gotName, got := at.In(custom).Zone()
+				wantName, want := at.In(location).Zone()
+
+				if got != want || gotName != wantName {
+					t.Fatalf("zone at %v = %s %d, want %s %d", at.UTC(), gotName, got, wantName, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTimeZoneWriteRead(t *testing.T) {
+	t.Parallel()
+
+	input := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VTIMEZONE\r\n" +
+		"TZID:Custom\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:20240101T000000\r\n" +
+		"TZOFFSETFROM:+063015\r\n" +
+		"TZOFFSETTO:+053000\r\n" +
+		"TZNAME:CST\r\n" +
+		"RDATE:20250101T000000\r\n" +
+		"END:STANDARD\r\n" +
+		"BEGIN:DAYLIGHT\r\n" +
+		"DTSTART:20240601T000000\r\n" +
+		"TZOFFSETFROM:+0530\r\n" +
+		"TZOFFSETTO:+063015\r\n" +
+		"TZNAME:CDT\r\n" +
+		"END:DAYLIGHT\r\n" +
+		"END:VTIMEZONE\r\n" +
+		"END:VCALENDAR\r\n"
+
+	calendar, err := dteical.Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	var out strings.Builder
+
+	written := dteical.Calendar{ProdID: "-//test//EN", Name: "", TimeZones: calendar.TimeZones, Events: nil}
+
+	err = dteical.Write(&out, written)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	want := strings.Replace(input, "BEGIN:VCALENDAR\r\n", "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//test//EN\r\n", 1)
+	want = strings.Replace(want, "+053000", "+0530", 1)
+
+	if out.String() != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", out.String(), want)
+	}
+
+	custom, err := calendar.TimeZones[0].Location()
+	if err != nil {
+		t.Fatalf("Location() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		at   string
+		name string
+		want int
+	}{
+		{at: "2023-12-31T18:30:00Z", name: "CST", want: 19800},
+		{at: "2024-05-31T18:30:00Z", name: "CDT", want: 23415},
+		{at: "2024-12-31T17:29:44Z", name: "CDT", want: 23415},
+		{at: "2024-12-31T17:29:45Z", name: "CST", want: 19800},
+	} {
+		if name, got := mustParse(t, tt.at).In(custom).Zone(); got != tt.want || name != tt.name {
+			t.Errorf("zone at %s = %s %d, want %s %d", tt.at, name, got, tt.name, tt.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package dtemsgpack
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+var (
+	ErrNewDate               = errors.New("failed to create new date")
+	ErrDateDecode            = errors.New("failed to decode msgpack value into date struct")
+	ErrDateDecodeInvalidType = errors.New("invalid msgpack type passed to date decode")
+)
+
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	secondsPerDay    = 24 * secondsPerHour
+)
+
+type Date struct { //nolint:recvcheck
+	dte.Date `example:"2006-01-02" format:"date"`
+}
+
+var (
+	_ msgpack.CustomEncoder = Date{}
+	_ msgpack.CustomDecoder = (*Date)(nil)
+)
+
+func NewDate(s string) (Date, error) {
+	timeInstance := Date{}
+
+	err := timeInstance.SetFromString(s)
+	if err != nil {
+		return Date{}, fmt.Errorf("%w: %w", ErrNewDate, err)
+	}
+
+	return timeInstance, nil
+}
+
+// EncodeMsgpack implements the [msgpack.CustomEncoder] interface.
+// The date is encoded as an integer number of days since 1970-01-01.
+func (d Date) EncodeMsgpack(enc *msgpack.Encoder) error {
+	midnight := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+
+	err := enc.EncodeInt(midnight.Unix() / secondsPerDay)
+	if err != nil {
+		return fmt.Errorf("Date.EncodeMsgpack: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeMsgpack implements the [msgpack.CustomDecoder] interface.
+// The value may be an integer number of days since 1970-01-01, a string in the RFC 3339 format or yyyy-mm-dd,
+// or a msgpack timestamp extension (-1). The timestamp is converted to UTC before the date is taken.
+func (d *Date) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDateDecode, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		err = dec.DecodeNil()
+	case isInteger(code):
+		var days int64
+
+		days, err = dec.DecodeInt64()
+		if err == nil {
+			err = d.SetFromTime(time.Unix(days*secondsPerDay, 0).UTC())
+		}
+	case msgpcode.IsString(code):
+		var s string
+
+		s, err = dec.DecodeString()
+		if err == nil {
+			err = d.SetFromString(s)
+		}
+	case msgpcode.IsExt(code):
+		var decodedTime time.Time
+
+		decodedTime, err = dec.DecodeTime()
+		if err == nil {
+			err = d.SetFromTime(decodedTime.UTC())
+		}
+	default:
+		return ErrDateDecodeInvalidType
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDateDecode, err)
+	}
+
+	return nil
+}
+
+// isInteger reports whether the msgpack code is one of the signed or unsigned integer families.
+func isInteger(code byte) bool {
+	return msgpcode.IsFixedNum(code) || (code >= msgpcode.Uint8 && code <= msgpcode.Int64)
+}
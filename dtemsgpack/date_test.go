@@ -0,0 +1,184 @@
+package dtemsgpack_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtemsgpack"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func ExampleDate() {
+	type DateExample struct {
+		OnlyDate dtemsgpack.Date `msgpack:"only_date"`
+	}
+
+	onlyDate, err := dtemsgpack.NewDate("2006-01-02")
+	if err != nil {
+		return
+	}
+
+	encoded, err := msgpack.Marshal(DateExample{OnlyDate: onlyDate})
+	if err != nil {
+		return
+	}
+
+	var exampleResult DateExample
+
+	err = msgpack.Unmarshal(encoded, &exampleResult)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.OnlyDate.String())
+
+	// Output: 2006-01-02
+}
+
+func TestDateEncodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputDate string
+		want      int64
+	}{
+		{
+			name:      "epoch",
+			inputDate: "1970-01-01",
+			want:      0,
+		},
+		{
+			name:      "after epoch",
+			inputDate: "2006-01-02",
+			want:      13150,
+		},
+		{
+			name:      "before epoch",
+			inputDate: "1969-12-31",
+			want:      -1,
+		},
+		{
+			name:      "full timestamp keeps the date",
+			inputDate: "2006-01-02T23:04:05-05:00",
+			want:      13150,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dtemsgpack.NewDate(tt.inputDate)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			encoded, err := msgpack.Marshal(onlyDate)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			var got int64
+
+			err = msgpack.Unmarshal(encoded, &got)
+			if err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("EncodeMsgpack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:funlen
+func TestDateDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   any
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "day number",
+			input:   13150,
+			want:    "2006-01-02",
+			wantErr: false,
+		},
+		{
+			name:    "negative day number",
+			input:   -1,
+			want:    "1969-12-31",
+			wantErr: false,
+		},
+		{
+			name:    "date string",
+			input:   "2006-01-02",
+			want:    "2006-01-02",
+			wantErr: false,
+		},
+		{
+			name:    "RFC 3339 string",
+			input:   "2006-01-02T15:04:05Z",
+			want:    "2006-01-02",
+			wantErr: false,
+		},
+		{
+			name:    "timestamp extension",
+			input:   time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC),
+			want:    "2006-01-02",
+			wantErr: false,
+		},
+		{
+			name:    "timestamp extension is converted to UTC",
+			input:   time.Date(2006, 1, 2, 20, 4, 5, 0, time.FixedZone("UTC-5", -5*3600)),
+			want:    "2006-01-03",
+			wantErr: false,
+		},
+		{
+			name:    "invalid string",
+			input:   "2006-13-02",
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid type",
+			input:   true,
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := msgpack.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			var got dtemsgpack.Date
+
+			err = msgpack.Unmarshal(encoded, &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeMsgpack() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("DecodeMsgpack() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/peterHoburg/go-date-and-time-extension/dtemsgpack
+
+go 1.23.4
+
+require (
+	github.com/peterHoburg/go-date-and-time-extension/dte v0.2.0
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+)
+
+require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
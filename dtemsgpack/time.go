@@ -0,0 +1,131 @@
+package dtemsgpack
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+var (
+	ErrNewTime               = errors.New("failed to create new time")
+	ErrTimeDecode            = errors.New("failed to decode msgpack value into time struct")
+	ErrTimeDecodeInvalidType = errors.New("invalid msgpack type passed to time decode")
+	ErrTimeDecodeOutOfRange  = errors.New("seconds of day or offset out of range")
+)
+
+// timeArrayLen is the number of elements in the encoded time: seconds of day and offset in seconds.
+const timeArrayLen = 2
+
+type Time struct { //nolint:recvcheck
+	dte.Time `example:"15:04:05Z" format:"time"`
+}
+
+var (
+	_ msgpack.CustomEncoder = Time{}
+	_ msgpack.CustomDecoder = (*Time)(nil)
+)
+
+func NewTime(s string) (Time, error) {
+	timeInstance := Time{}
+
+	err := timeInstance.SetFromString(s)
+	if err != nil {
+		return Time{}, fmt.Errorf("%w: %w", ErrNewTime, err)
+	}
+
+	return timeInstance, nil
+}
+
+// EncodeMsgpack implements the [msgpack.CustomEncoder] interface.
+// The time is encoded as a two element array of the seconds since midnight and the UTC offset in seconds.
+func (t Time) EncodeMsgpack(enc *msgpack.Encoder) error {
+	hour, minute, second := t.Clock()
+	_, offset := t.Zone()
+
+	err := enc.EncodeArrayLen(timeArrayLen)
+	if err == nil {
+		err = enc.EncodeInt(int64(hour*secondsPerHour + minute*secondsPerMinute + second))
+	}
+
+	if err == nil {
+		err = enc.EncodeInt(int64(offset))
+	}
+
+	if err != nil {
+		return fmt.Errorf("Time.EncodeMsgpack: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeMsgpack implements the [msgpack.CustomDecoder] interface.
+// The value may be the two element array written by EncodeMsgpack, a string in the RFC 3339 format or
+// hh:mm:ss with a timezone, or a msgpack timestamp extension (-1).
+func (t *Time) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTimeDecode, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		err = dec.DecodeNil()
+	case msgpcode.IsFixedArray(code) || code == msgpcode.Array16 || code == msgpcode.Array32:
+		err = t.decodeArray(dec)
+	case msgpcode.IsString(code):
+		var s string
+
+		s, err = dec.DecodeString()
+		if err == nil {
+			err = t.SetFromString(s)
+		}
+	case msgpcode.IsExt(code):
+		var decodedTime time.Time
+
+		decodedTime, err = dec.DecodeTime()
+		if err == nil {
+			err = t.SetFromTime(decodedTime)
+		}
+	default:
+		return ErrTimeDecodeInvalidType
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTimeDecode, err)
+	}
+
+	return nil
+}
+
+func (t *Time) decodeArray(dec *msgpack.Decoder) error {
+	length, err := dec.DecodeArrayLen()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if length != timeArrayLen {
+		return fmt.Errorf("%w: expected %d elements, got %d", ErrTimeDecodeInvalidType, timeArrayLen, length)
+	}
+
+	seconds, err := dec.DecodeInt64()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	offset, err := dec.DecodeInt64()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if seconds < 0 || seconds >= secondsPerDay || offset <= -secondsPerDay || offset >= secondsPerDay {
+		return fmt.Errorf("%w: seconds %d, offset %d", ErrTimeDecodeOutOfRange, seconds, offset)
+	}
+
+	location := time.FixedZone("", int(offset))
+
+	return t.SetFromTime(time.Date(0, time.January, 1, 0, 0, int(seconds), 0, location))
+}
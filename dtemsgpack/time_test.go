@@ -0,0 +1,176 @@
+package dtemsgpack_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtemsgpack"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func ExampleTime() {
+	type TimeExample struct {
+		OnlyTime dtemsgpack.Time `msgpack:"only_time"`
+	}
+
+	onlyTime, err := dtemsgpack.NewTime("10:04:05-05:00")
+	if err != nil {
+		return
+	}
+
+	encoded, err := msgpack.Marshal(TimeExample{OnlyTime: onlyTime})
+	if err != nil {
+		return
+	}
+
+	var exampleResult TimeExample
+
+	err = msgpack.Unmarshal(encoded, &exampleResult)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(exampleResult.OnlyTime.String())
+
+	// Output: 15:04:05Z
+}
+
+func TestTimeEncodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputTime dte.Time
+		want      []int64
+	}{
+		{
+			name:      "midnight",
+			inputTime: func() dte.Time { t, _ := dte.NewTime("00:00:00Z"); return t }(), //nolint:nlreturn
+			want:      []int64{0, 0},
+		},
+		{
+			name:      "parsed time is UTC",
+			inputTime: func() dte.Time { t, _ := dte.NewTime("10:04:05-05:00"); return t }(), //nolint:nlreturn
+			want:      []int64{54245, 0},
+		},
+		{
+			name:      "offset is kept",
+			inputTime: dte.Time{Time: time.Date(0, 1, 1, 10, 4, 5, 0, time.FixedZone("UTC-5", -5*3600))},
+			want:      []int64{36245, -18000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := msgpack.Marshal(dtemsgpack.Time{Time: tt.inputTime})
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			var got []int64
+
+			err = msgpack.Unmarshal(encoded, &got)
+			if err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("EncodeMsgpack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:funlen
+func TestTimeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   any
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "seconds and offset",
+			input:   []int64{36245, -18000},
+			want:    "15:04:05Z",
+			wantErr: false,
+		},
+		{
+			name:    "seconds out of range",
+			input:   []int64{86400, 0},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "offset out of range",
+			input:   []int64{0, 86400},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "wrong array length",
+			input:   []int64{0},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "time string",
+			input:   "10:04:05-05:00",
+			want:    "15:04:05Z",
+			wantErr: false,
+		},
+		{
+			name:    "timestamp extension",
+			input:   time.Date(2006, 1, 2, 10, 4, 5, 0, time.FixedZone("UTC-5", -5*3600)),
+			want:    "15:04:05Z",
+			wantErr: false,
+		},
+		{
+			name:    "invalid string",
+			input:   "15:04:05",
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid type",
+			input:   1,
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := msgpack.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			var got dtemsgpack.Time
+
+			err = msgpack.Unmarshal(encoded, &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeMsgpack() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("DecodeMsgpack() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package dtepb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+var (
+	ErrDateProtoNil        = errors.New("google.type.Date is nil")
+	ErrDateProtoInvalid    = errors.New("google.type.Date is not a valid calendar date")
+	ErrDateProtoPartial    = errors.New("google.type.Date is a partial date")
+	ErrDateProtoNoYear     = errors.New("google.type.Date has no year")
+	ErrDateProtoOutOfRange = errors.New("date year is outside of the google.type.Date range 1-9999")
+)
+
+const (
+	minProtoYear = 1
+	maxProtoYear = 9999
+)
+
+// DateToProto converts the date to a full google.type.Date.
+// Dates with a year outside of 1-9999 can not be represented and return an error.
+func DateToProto(d dte.Date) (*date.Date, error) {
+	year, month, day := d.Date()
+
+	if year < minProtoYear || year > maxProtoYear {
+		return nil, fmt.Errorf("%w: %d", ErrDateProtoOutOfRange, year)
+	}
+
+	return &date.Date{Year: int32(year), Month: int32(month), Day: int32(day)}, nil //nolint:gosec
+}
+
+// DateFromProto converts a full google.type.Date to a date.
+// Partial dates, with a zero year, month or day, return ErrDateProtoPartial. Use DateBoundsFromProto for those.
+func DateFromProto(pb *date.Date) (dte.Date, error) {
+	err := validateDateProto(pb)
+	if err != nil {
+		return dte.Date{}, err
+	}
+
+	if pb.GetYear() == 0 || pb.GetMonth() == 0 || pb.GetDay() == 0 {
+		return dte.Date{}, fmt.Errorf("%w: %04d-%02d-%02d", ErrDateProtoPartial, pb.GetYear(), pb.GetMonth(), pb.GetDay())
+	}
+
+	return newDate(int(pb.GetYear()), time.Month(pb.GetMonth()), int(pb.GetDay())), nil
+}
+
+// DateBoundsFromProto returns the first and last date covered by a full or partial google.type.Date.
+// A year on its own covers the whole year and a year and month covers the whole month.
+// A month and day without a year, such as an anniversary, returns ErrDateProtoNoYear.
+func DateBoundsFromProto(pb *date.Date) (dte.Date, dte.Date, error) {
+	err := validateDateProto(pb)
+	if err != nil {
+		return dte.Date{}, dte.Date{}, err
+	}
+
+	year, month, day := int(pb.GetYear()), time.Month(pb.GetMonth()), int(pb.GetDay())
+
+	switch {
+	case year == 0:
+		return dte.Date{}, dte.Date{}, fmt.Errorf("%w: --%02d-%02d", ErrDateProtoNoYear, month, day)
+	case month == 0:
+		return newDate(year, time.January, 1), newDate(year, time.December, 31), nil //nolint:mnd
+	case day == 0:
+		return newDate(year, month, 1), newDate(year, month+1, 0), nil
+	default:
+		onlyDate := newDate(year, month, day)
+
+		return onlyDate, onlyDate, nil
+	}
+}
+
+// validateDateProto checks the field ranges of a full or partial google.type.Date.
+func validateDateProto(pb *date.Date) error {
+	if pb == nil {
+		return ErrDateProtoNil
+	}
+
+	year, month, day := int(pb.GetYear()), int(pb.GetMonth()), int(pb.GetDay())
+
+	switch {
+	case year < 0 || year > maxProtoYear,
+		month < 0 || month > int(time.December),
+		day < 0,
+		month == 0 && day != 0,
+		year == 0 && (month == 0 || day == 0):
+		return fmt.Errorf("%w: %04d-%02d-%02d", ErrDateProtoInvalid, year, month, day)
+	}
+
+	if day == 0 {
+		return nil
+	}
+
+	// Year 0 stands in for "any year", so Feb 29 is checked against a leap year.
+	checkYear := year
+	if checkYear == 0 {
+		checkYear = 2000
+	}
+
+	if newDate(checkYear, time.Month(month), day).Day() != day {
+		return fmt.Errorf("%w: %04d-%02d-%02d", ErrDateProtoInvalid, year, month, day)
+	}
+
+	return nil
+}
+
+// newDate returns the date at midnight UTC, the same value dte.NewDate produces for yyyy-mm-dd.
+func newDate(year int, month time.Month, day int) dte.Date {
+	return dte.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
@@ -0,0 +1,202 @@
+package dtepb_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtepb"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+func ExampleDateToProto() {
+	onlyDate, err := dte.NewDate("2006-01-02")
+	if err != nil {
+		return
+	}
+
+	pb, err := dtepb.DateToProto(onlyDate)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(pb.GetYear(), pb.GetMonth(), pb.GetDay())
+
+	// Output: 2006 1 2
+}
+
+func ExampleDateBoundsFromProto() {
+	first, last, err := dtepb.DateBoundsFromProto(&date.Date{Year: 2024, Month: 2})
+	if err != nil {
+		return
+	}
+
+	fmt.Println(first, last)
+
+	// Output: 2024-02-01 2024-02-29
+}
+
+func TestDateToProto(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputDate string
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "valid date",
+			inputDate: "2006-01-02",
+			want:      "2006-01-02",
+			wantErr:   nil,
+		},
+		{
+			name:      "full timestamp",
+			inputDate: "2006-01-02T23:04:05-05:00",
+			want:      "2006-01-02",
+			wantErr:   nil,
+		},
+		{
+			name:      "year zero",
+			inputDate: "0000-01-01",
+			want:      "",
+			wantErr:   dtepb.ErrDateProtoOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			onlyDate, err := dte.NewDate(tt.inputDate)
+			if err != nil {
+				t.Fatalf("NewDate() error = %v", err)
+			}
+
+			pb, err := dtepb.DateToProto(onlyDate)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DateToProto() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			got, err := dtepb.DateFromProto(pb)
+			if err != nil {
+				t.Fatalf("DateFromProto() error = %v", err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("DateToProto() round trip = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:funlen
+func TestDateFromProto(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     *date.Date
+		want      string
+		wantFirst string
+		wantLast  string
+		wantErr   error
+	}{
+		{
+			name:      "full date",
+			input:     &date.Date{Year: 2024, Month: 2, Day: 29},
+			want:      "2024-02-29",
+			wantFirst: "2024-02-29",
+			wantLast:  "2024-02-29",
+			wantErr:   nil,
+		},
+		{
+			name:      "year and month",
+			input:     &date.Date{Year: 2023, Month: 2},
+			want:      "",
+			wantFirst: "2023-02-01",
+			wantLast:  "2023-02-28",
+			wantErr:   dtepb.ErrDateProtoPartial,
+		},
+		{
+			name:      "year only",
+			input:     &date.Date{Year: 2024},
+			want:      "",
+			wantFirst: "2024-01-01",
+			wantLast:  "2024-12-31",
+			wantErr:   dtepb.ErrDateProtoPartial,
+		},
+		{
+			name:      "anniversary",
+			input:     &date.Date{Month: 2, Day: 29},
+			want:      "",
+			wantFirst: "",
+			wantLast:  "",
+			wantErr:   dtepb.ErrDateProtoPartial,
+		},
+		{
+			name:      "not a leap year",
+			input:     &date.Date{Year: 2023, Month: 2, Day: 29},
+			want:      "",
+			wantFirst: "",
+			wantLast:  "",
+			wantErr:   dtepb.ErrDateProtoInvalid,
+		},
+		{
+			name:      "day without month",
+			input:     &date.Date{Year: 2023, Day: 2},
+			want:      "",
+			wantFirst: "",
+			wantLast:  "",
+			wantErr:   dtepb.ErrDateProtoInvalid,
+		},
+		{
+			name:      "nil",
+			input:     nil,
+			want:      "",
+			wantFirst: "",
+			wantLast:  "",
+			wantErr:   dtepb.ErrDateProtoNil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dtepb.DateFromProto(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DateFromProto() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("DateFromProto() = %v, want %v", got, tt.want)
+			}
+
+			first, last, err := dtepb.DateBoundsFromProto(tt.input)
+			if (err != nil) != (tt.wantFirst == "") {
+				t.Errorf("DateBoundsFromProto() error = %v, want first %v", err, tt.wantFirst)
+
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if first.String() != tt.wantFirst || last.String() != tt.wantLast {
+				t.Errorf("DateBoundsFromProto() = %v %v, want %v %v", first, last, tt.wantFirst, tt.wantLast)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package dtepb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	ErrDateTimeProtoNil        = errors.New("google.type.DateTime is nil")
+	ErrDateTimeProtoInvalid    = errors.New("google.type.DateTime is not a valid date time")
+	ErrDateTimeProtoNoYear     = errors.New("google.type.DateTime has no year")
+	ErrDateTimeProtoCivil      = errors.New("google.type.DateTime has neither a time zone nor a UTC offset")
+	ErrDateTimeProtoTimeZone   = errors.New("google.type.DateTime time zone could not be loaded")
+	ErrDateTimeProtoUtcOffset  = errors.New("google.type.DateTime UTC offset is not a whole number of seconds under 24h")
+	ErrDateTimeProtoOutOfRange = errors.New("time year is outside of the google.type.DateTime range 1-9999")
+)
+
+// DateTimeToProto converts a zoned time to a google.type.DateTime.
+// Times in an IANA time zone set time_zone, all other times, including UTC and Local, set utc_offset.
+func DateTimeToProto(t time.Time) (*datetime.DateTime, error) {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	if year < minProtoYear || year > maxProtoYear {
+		return nil, fmt.Errorf("%w: %d", ErrDateTimeProtoOutOfRange, year)
+	}
+
+	pb := &datetime.DateTime{
+		Year:    int32(year),           //nolint:gosec
+		Month:   int32(month),          //nolint:gosec
+		Day:     int32(day),            //nolint:gosec
+		Hours:   int32(hour),           //nolint:gosec
+		Minutes: int32(minute),         //nolint:gosec
+		Seconds: int32(second),         //nolint:gosec
+		Nanos:   int32(t.Nanosecond()), //nolint:gosec
+	}
+
+	if isTimeZone(t.Location()) {
+		pb.TimeOffset = &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: t.Location().String()}}
+	} else {
+		_, offset := t.Zone()
+		pb.TimeOffset = &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(time.Duration(offset) * time.Second)}
+	}
+
+	return pb, nil
+}
+
+// DateTimeFromProto converts a google.type.DateTime with a time zone or UTC offset to a zoned time.
+// Civil date times, with neither set, return ErrDateTimeProtoCivil. Use DateTimeFromProtoInLocation for those.
+func DateTimeFromProto(pb *datetime.DateTime) (time.Time, error) {
+	return DateTimeFromProtoInLocation(pb, nil)
+}
+
+// DateTimeFromProtoInLocation converts a google.type.DateTime to a zoned time.
+// The time zone or UTC offset of the message wins, civil date times are placed in loc.
+// Civil times that do not exist in the time zone, because they fall in a DST gap, return ErrDateTimeProtoInvalid.
+func DateTimeFromProtoInLocation(pb *datetime.DateTime, loc *time.Location) (time.Time, error) {
+	if pb == nil {
+		return time.Time{}, ErrDateTimeProtoNil
+	}
+
+	if pb.GetYear() == 0 {
+		return time.Time{}, ErrDateTimeProtoNoYear
+	}
+
+	err := validateDateProto(&date.Date{Year: pb.GetYear(), Month: pb.GetMonth(), Day: pb.GetDay()})
+	if err == nil && (pb.GetMonth() == 0 || pb.GetDay() == 0) {
+		err = ErrDateProtoPartial
+	}
+
+	if err == nil {
+		err = validateClock(pb.GetHours(), pb.GetMinutes(), pb.GetSeconds(), pb.GetNanos())
+	}
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrDateTimeProtoInvalid, err)
+	}
+
+	loc, err = dateTimeLocation(pb, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	converted := time.Date(
+		int(pb.GetYear()), time.Month(pb.GetMonth()), int(pb.GetDay()),
+		int(pb.GetHours()), int(pb.GetMinutes()), int(pb.GetSeconds()), int(pb.GetNanos()),
+		loc,
+	)
+
+	if converted.Hour() != int(pb.GetHours()) || converted.Minute() != int(pb.GetMinutes()) {
+		return time.Time{}, fmt.Errorf(
+			"%w: %02d:%02d does not exist in %s", ErrDateTimeProtoInvalid, pb.GetHours(), pb.GetMinutes(), loc,
+		)
+	}
+
+	return converted, nil
+}
+
+func dateTimeLocation(pb *datetime.DateTime, loc *time.Location) (*time.Location, error) {
+	switch offset := pb.GetTimeOffset().(type) {
+	case *datetime.DateTime_TimeZone:
+		zone, err := time.LoadLocation(offset.TimeZone.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDateTimeProtoTimeZone, err)
+		}
+
+		return zone, nil
+	case *datetime.DateTime_UtcOffset:
+		err := offset.UtcOffset.CheckValid()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDateTimeProtoUtcOffset, err)
+		}
+
+		duration := offset.UtcOffset.AsDuration()
+		if duration%time.Second != 0 || duration <= -24*time.Hour || duration >= 24*time.Hour {
+			return nil, fmt.Errorf("%w: %s", ErrDateTimeProtoUtcOffset, duration)
+		}
+
+		if duration == 0 {
+			return time.UTC, nil
+		}
+
+		return time.FixedZone("", int(duration/time.Second)), nil
+	default:
+		if loc == nil {
+			return nil, ErrDateTimeProtoCivil
+		}
+
+		return loc, nil
+	}
+}
+
+// isTimeZone reports whether the location is a named IANA time zone that can be loaded again by its name.
+func isTimeZone(loc *time.Location) bool {
+	name := loc.String()
+	if loc == time.UTC || name == "Local" || name == "" {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+
+	return err == nil
+}
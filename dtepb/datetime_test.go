@@ -0,0 +1,209 @@
+package dtepb_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dtepb"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func ExampleDateTimeToProto() {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		return
+	}
+
+	pb, err := dtepb.DateTimeToProto(time.Date(2024, 7, 1, 9, 30, 0, 0, london))
+	if err != nil {
+		return
+	}
+
+	fmt.Println(pb.GetTimeZone().GetId())
+
+	// Output: Europe/London
+}
+
+func TestDateTimeToProto(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		input      time.Time
+		wantZone   string
+		wantOffset time.Duration
+		wantErr    error
+	}{
+		{
+			name:       "time zone",
+			input:      time.Date(2024, 3, 10, 12, 0, 0, 0, newYork),
+			wantZone:   "America/New_York",
+			wantOffset: 0,
+			wantErr:    nil,
+		},
+		{
+			name:       "UTC",
+			input:      time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC),
+			wantZone:   "",
+			wantOffset: 0,
+			wantErr:    nil,
+		},
+		{
+			name:       "fixed offset",
+			input:      time.Date(2024, 3, 10, 12, 0, 0, 0, time.FixedZone("UTC-5", -5*3600)),
+			wantZone:   "",
+			wantOffset: -5 * time.Hour,
+			wantErr:    nil,
+		},
+		{
+			name:       "year out of range",
+			input:      time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantZone:   "",
+			wantOffset: 0,
+			wantErr:    dtepb.ErrDateTimeProtoOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pb, err := dtepb.DateTimeToProto(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DateTimeToProto() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if pb.GetTimeZone().GetId() != tt.wantZone {
+				t.Errorf("DateTimeToProto() time zone = %v, want %v", pb.GetTimeZone().GetId(), tt.wantZone)
+			}
+
+			if tt.wantZone == "" && pb.GetUtcOffset().AsDuration() != tt.wantOffset {
+				t.Errorf("DateTimeToProto() offset = %v, want %v", pb.GetUtcOffset().AsDuration(), tt.wantOffset)
+			}
+
+			got, err := dtepb.DateTimeFromProto(pb)
+			if err != nil {
+				t.Fatalf("DateTimeFromProto() error = %v", err)
+			}
+
+			if !got.Equal(tt.input) {
+				t.Errorf("DateTimeFromProto() = %v, want %v", got, tt.input)
+			}
+		})
+	}
+}
+
+//nolint:funlen
+func TestDateTimeFromProtoInLocation(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		input   *datetime.DateTime
+		loc     *time.Location
+		want    time.Time
+		wantErr error
+	}{
+		{
+			name:    "civil time in location",
+			input:   &datetime.DateTime{Year: 2024, Month: 3, Day: 10, Hours: 12},
+			loc:     newYork,
+			want:    time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC),
+			wantErr: nil,
+		},
+		{
+			name:    "civil time without location",
+			input:   &datetime.DateTime{Year: 2024, Month: 3, Day: 10, Hours: 12},
+			loc:     nil,
+			want:    time.Time{},
+			wantErr: dtepb.ErrDateTimeProtoCivil,
+		},
+		{
+			name: "offset wins over location",
+			input: &datetime.DateTime{
+				Year: 2024, Month: 3, Day: 10, Hours: 12,
+				TimeOffset: &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(time.Hour)},
+			},
+			loc:     newYork,
+			want:    time.Date(2024, 3, 10, 11, 0, 0, 0, time.UTC),
+			wantErr: nil,
+		},
+		{
+			name:    "DST gap",
+			input:   &datetime.DateTime{Year: 2024, Month: 3, Day: 10, Hours: 2, Minutes: 30},
+			loc:     newYork,
+			want:    time.Time{},
+			wantErr: dtepb.ErrDateTimeProtoInvalid,
+		},
+		{
+			name: "unknown time zone",
+			input: &datetime.DateTime{
+				Year: 2024, Month: 3, Day: 10,
+				TimeOffset: &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: "Mars/Olympus"}},
+			},
+			loc:     nil,
+			want:    time.Time{},
+			wantErr: dtepb.ErrDateTimeProtoTimeZone,
+		},
+		{
+			name: "fractional offset",
+			input: &datetime.DateTime{
+				Year: 2024, Month: 3, Day: 10,
+				TimeOffset: &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(time.Millisecond)},
+			},
+			loc:     nil,
+			want:    time.Time{},
+			wantErr: dtepb.ErrDateTimeProtoUtcOffset,
+		},
+		{
+			name:    "no year",
+			input:   &datetime.DateTime{Month: 3, Day: 10},
+			loc:     time.UTC,
+			want:    time.Time{},
+			wantErr: dtepb.ErrDateTimeProtoNoYear,
+		},
+		{
+			name:    "partial date",
+			input:   &datetime.DateTime{Year: 2024, Month: 3},
+			loc:     time.UTC,
+			want:    time.Time{},
+			wantErr: dtepb.ErrDateTimeProtoInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dtepb.DateTimeFromProtoInLocation(tt.input, tt.loc)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DateTimeFromProtoInLocation() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("DateTimeFromProtoInLocation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
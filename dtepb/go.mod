@@ -0,0 +1,9 @@
+module github.com/peterHoburg/go-date-and-time-extension/dtepb
+
+go 1.23.4
+
+require (
+	github.com/peterHoburg/go-date-and-time-extension/dte v0.2.0
+	google.golang.org/genproto v0.0.0-20240903143218-8af14fe29dc1
+	google.golang.org/protobuf v1.34.2
+)
@@ -0,0 +1,74 @@
+package dtepb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrIntervalProtoNil         = errors.New("google.type.Interval is nil")
+	ErrIntervalProtoInvalid     = errors.New("google.type.Interval is not a valid interval")
+	ErrIntervalProtoUnbounded   = errors.New("google.type.Interval has no start or end time")
+	ErrIntervalProtoNotMidnight = errors.New("google.type.Interval bounds are not at midnight UTC")
+	ErrIntervalDatesReversed    = errors.New("interval end date is before the start date")
+)
+
+// IntervalToProto converts the inclusive date range start to end to a google.type.Interval.
+// The interval starts at midnight UTC of start and ends, exclusively, at midnight UTC of the day after end.
+func IntervalToProto(start dte.Date, end dte.Date) (*interval.Interval, error) {
+	startTime := midnight(start)
+	endTime := midnight(end)
+
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrIntervalDatesReversed, start, end)
+	}
+
+	return &interval.Interval{
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(endTime.AddDate(0, 0, 1)),
+	}, nil
+}
+
+// DatesFromInterval converts a google.type.Interval to the inclusive date range it covers.
+// Both bounds must be set and at midnight UTC, and the interval must not be empty.
+func DatesFromInterval(pb *interval.Interval) (dte.Date, dte.Date, error) {
+	if pb == nil {
+		return dte.Date{}, dte.Date{}, ErrIntervalProtoNil
+	}
+
+	if pb.GetStartTime() == nil || pb.GetEndTime() == nil {
+		return dte.Date{}, dte.Date{}, ErrIntervalProtoUnbounded
+	}
+
+	for _, timestamp := range []*timestamppb.Timestamp{pb.GetStartTime(), pb.GetEndTime()} {
+		err := timestamp.CheckValid()
+		if err != nil {
+			return dte.Date{}, dte.Date{}, fmt.Errorf("%w: %w", ErrIntervalProtoInvalid, err)
+		}
+
+		if !timestamp.AsTime().Equal(timestamp.AsTime().Truncate(24 * time.Hour)) {
+			return dte.Date{}, dte.Date{}, fmt.Errorf("%w: %s", ErrIntervalProtoNotMidnight, timestamp.AsTime())
+		}
+	}
+
+	startTime := pb.GetStartTime().AsTime()
+	endTime := pb.GetEndTime().AsTime()
+
+	if !startTime.Before(endTime) {
+		return dte.Date{}, dte.Date{}, fmt.Errorf("%w: %s is not before %s", ErrIntervalProtoInvalid, startTime, endTime)
+	}
+
+	lastDay := endTime.AddDate(0, 0, -1)
+
+	return newDate(startTime.Date()), newDate(lastDay.Date()), nil
+}
+
+// midnight returns the start of the date in UTC, ignoring any time of day kept from an RFC 3339 input.
+func midnight(d dte.Date) time.Time {
+	return newDate(d.Date()).Time
+}
@@ -0,0 +1,141 @@
+package dtepb_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtepb"
+	"google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ExampleIntervalToProto() {
+	start, err := dte.NewDate("2024-01-01")
+	if err != nil {
+		return
+	}
+
+	end, err := dte.NewDate("2024-01-31")
+	if err != nil {
+		return
+	}
+
+	pb, err := dtepb.IntervalToProto(start, end)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(pb.GetStartTime().AsTime().Format(time.RFC3339), pb.GetEndTime().AsTime().Format(time.RFC3339))
+
+	// Output: 2024-01-01T00:00:00Z 2024-02-01T00:00:00Z
+}
+
+func TestIntervalToProto(t *testing.T) {
+	t.Parallel()
+
+	start, _ := dte.NewDate("2024-01-02")
+	end, _ := dte.NewDate("2024-01-01")
+
+	_, err := dtepb.IntervalToProto(start, end)
+	if !errors.Is(err, dtepb.ErrIntervalDatesReversed) {
+		t.Errorf("IntervalToProto() error = %v, wantErr %v", err, dtepb.ErrIntervalDatesReversed)
+	}
+
+	pb, err := dtepb.IntervalToProto(start, start)
+	if err != nil {
+		t.Fatalf("IntervalToProto() error = %v", err)
+	}
+
+	gotStart, gotEnd, err := dtepb.DatesFromInterval(pb)
+	if err != nil {
+		t.Fatalf("DatesFromInterval() error = %v", err)
+	}
+
+	if gotStart != start || gotEnd != start {
+		t.Errorf("DatesFromInterval() = %v %v, want %v %v", gotStart, gotEnd, start, start)
+	}
+}
+
+//nolint:funlen
+func TestDatesFromInterval(t *testing.T) {
+	t.Parallel()
+
+	midnight := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     *interval.Interval
+		wantStart string
+		wantEnd   string
+		wantErr   error
+	}{
+		{
+			name: "one week",
+			input: &interval.Interval{
+				StartTime: timestamppb.New(midnight),
+				EndTime:   timestamppb.New(midnight.AddDate(0, 0, 7)),
+			},
+			wantStart: "2024-01-01",
+			wantEnd:   "2024-01-07",
+			wantErr:   nil,
+		},
+		{
+			name: "empty",
+			input: &interval.Interval{
+				StartTime: timestamppb.New(midnight),
+				EndTime:   timestamppb.New(midnight),
+			},
+			wantStart: "",
+			wantEnd:   "",
+			wantErr:   dtepb.ErrIntervalProtoInvalid,
+		},
+		{
+			name: "not midnight",
+			input: &interval.Interval{
+				StartTime: timestamppb.New(midnight.Add(time.Hour)),
+				EndTime:   timestamppb.New(midnight.AddDate(0, 0, 1)),
+			},
+			wantStart: "",
+			wantEnd:   "",
+			wantErr:   dtepb.ErrIntervalProtoNotMidnight,
+		},
+		{
+			name:      "unbounded",
+			input:     &interval.Interval{StartTime: timestamppb.New(midnight)},
+			wantStart: "",
+			wantEnd:   "",
+			wantErr:   dtepb.ErrIntervalProtoUnbounded,
+		},
+		{
+			name:      "nil",
+			input:     nil,
+			wantStart: "",
+			wantEnd:   "",
+			wantErr:   dtepb.ErrIntervalProtoNil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotStart, gotEnd, err := dtepb.DatesFromInterval(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DatesFromInterval() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if gotStart.String() != tt.wantStart || gotEnd.String() != tt.wantEnd {
+				t.Errorf("DatesFromInterval() = %v %v, want %v %v", gotStart, gotEnd, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
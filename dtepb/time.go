@@ -0,0 +1,74 @@
+package dtepb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+)
+
+var (
+	ErrTimeOfDayProtoNil     = errors.New("google.type.TimeOfDay is nil")
+	ErrTimeOfDayProtoInvalid = errors.New("google.type.TimeOfDay is not a valid time of day")
+
+	errClockOutOfRange = errors.New("field out of range")
+)
+
+const (
+	maxHours   = 23
+	maxMinutes = 59
+	maxSeconds = 59
+	maxNanos   = 999_999_999
+)
+
+// TimeToProto converts the time to a google.type.TimeOfDay.
+// google.type.TimeOfDay has no time zone, so the time of day is taken in UTC, the same as Time.String.
+func TimeToProto(t dte.Time) *timeofday.TimeOfDay {
+	utc := t.UTC()
+	hour, minute, second := utc.Clock()
+
+	return &timeofday.TimeOfDay{
+		Hours:   int32(hour),             //nolint:gosec
+		Minutes: int32(minute),           //nolint:gosec
+		Seconds: int32(second),           //nolint:gosec
+		Nanos:   int32(utc.Nanosecond()), //nolint:gosec
+	}
+}
+
+// TimeFromProto converts a google.type.TimeOfDay to a time in UTC.
+// The closing time "24:00:00" and leap seconds can not be represented and return ErrTimeOfDayProtoInvalid.
+func TimeFromProto(pb *timeofday.TimeOfDay) (dte.Time, error) {
+	if pb == nil {
+		return dte.Time{}, ErrTimeOfDayProtoNil
+	}
+
+	err := validateClock(pb.GetHours(), pb.GetMinutes(), pb.GetSeconds(), pb.GetNanos())
+	if err != nil {
+		return dte.Time{}, fmt.Errorf("%w: %w", ErrTimeOfDayProtoInvalid, err)
+	}
+
+	return newTime(int(pb.GetHours()), int(pb.GetMinutes()), int(pb.GetSeconds()), int(pb.GetNanos())), nil
+}
+
+// validateClock checks the time of day fields shared by google.type.TimeOfDay and google.type.DateTime.
+func validateClock(hours, minutes, seconds, nanos int32) error {
+	switch {
+	case hours < 0 || hours > maxHours:
+		return fmt.Errorf("%w: hours %d", errClockOutOfRange, hours)
+	case minutes < 0 || minutes > maxMinutes:
+		return fmt.Errorf("%w: minutes %d", errClockOutOfRange, minutes)
+	case seconds < 0 || seconds > maxSeconds:
+		return fmt.Errorf("%w: seconds %d", errClockOutOfRange, seconds)
+	case nanos < 0 || nanos > maxNanos:
+		return fmt.Errorf("%w: nanos %d", errClockOutOfRange, nanos)
+	default:
+		return nil
+	}
+}
+
+// newTime returns the time of day in UTC, on the same zero date dte.NewTime produces.
+func newTime(hour, minute, second, nanosecond int) dte.Time {
+	return dte.Time{Time: time.Date(0, time.January, 1, hour, minute, second, nanosecond, time.UTC)}
+}
@@ -0,0 +1,120 @@
+package dtepb_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dtepb"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+)
+
+func ExampleTimeToProto() {
+	onlyTime, err := dte.NewTime("10:04:05-05:00")
+	if err != nil {
+		return
+	}
+
+	pb := dtepb.TimeToProto(onlyTime)
+
+	fmt.Println(pb.GetHours(), pb.GetMinutes(), pb.GetSeconds())
+
+	// Output: 15 4 5
+}
+
+//nolint:funlen
+func TestTimeFromProto(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   *timeofday.TimeOfDay
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "valid time",
+			input:   &timeofday.TimeOfDay{Hours: 15, Minutes: 4, Seconds: 5},
+			want:    "15:04:05Z",
+			wantErr: nil,
+		},
+		{
+			name:    "midnight",
+			input:   &timeofday.TimeOfDay{},
+			want:    "00:00:00Z",
+			wantErr: nil,
+		},
+		{
+			name:    "closing time",
+			input:   &timeofday.TimeOfDay{Hours: 24},
+			want:    "",
+			wantErr: dtepb.ErrTimeOfDayProtoInvalid,
+		},
+		{
+			name:    "leap second",
+			input:   &timeofday.TimeOfDay{Hours: 23, Minutes: 59, Seconds: 60},
+			want:    "",
+			wantErr: dtepb.ErrTimeOfDayProtoInvalid,
+		},
+		{
+			name:    "negative nanos",
+			input:   &timeofday.TimeOfDay{Nanos: -1},
+			want:    "",
+			wantErr: dtepb.ErrTimeOfDayProtoInvalid,
+		},
+		{
+			name:    "nil",
+			input:   nil,
+			want:    "",
+			wantErr: dtepb.ErrTimeOfDayProtoNil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dtepb.TimeFromProto(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("TimeFromProto() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("TimeFromProto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeProtoRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	pb := &timeofday.TimeOfDay{Hours: 23, Minutes: 59, Seconds: 59, Nanos: 999_999_999}
+
+	got, err := dtepb.TimeFromProto(pb)
+	if err != nil {
+		t.Fatalf("TimeFromProto() error = %v", err)
+	}
+
+	parsed, err := dte.NewTime("23:59:59Z")
+	if err != nil {
+		t.Fatalf("NewTime() error = %v", err)
+	}
+
+	if !got.Truncate(time.Second).Equal(parsed.Time) {
+		t.Errorf("TimeFromProto() = %v, want %v", got, parsed)
+	}
+
+	back := dtepb.TimeToProto(got)
+	if back.GetNanos() != pb.GetNanos() || back.GetSeconds() != pb.GetSeconds() {
+		t.Errorf("TimeToProto() = %v, want %v", back, pb)
+	}
+}
@@ -0,0 +1,368 @@
+package dteschedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+)
+
+var (
+	ErrNewScheduler = errors.New("scheduler can not be created from the options")
+	ErrRunning      = errors.New("scheduler is already running")
+)
+
+// DefaultTolerance is how late a run may start, after its jitter, before it counts as missed, when Daily.Tolerance is
+// zero.
+const DefaultTolerance = time.Minute
+
+const oneDay = 24 * time.Hour
+
+// recurrenceBatch is the fewest dates of a recurrence expanded at a time.
+const recurrenceBatch = 64
+
+// CatchUp is what a Scheduler does with runs it missed, because the process was not running or the machine was
+// asleep at their time, or because the run before them took too long.
+type CatchUp int
+
+const (
+	// CatchUpSkip leaves missed runs out and waits for the next one.
+	CatchUpSkip CatchUp = iota
+	// CatchUpLatest runs the latest missed run once, in place of all of them.
+	CatchUpLatest
+	// CatchUpAll runs every missed run, oldest first.
+	CatchUpAll
+)
+
+// Daily is when a Scheduler runs its job: at a wall clock time of day in a location, on every day, on some weekdays
+// or on the dates of a recurrence. A time of day that a daylight saving time change skips runs when the change
+// happens and one it repeats runs the first time only, so the job runs exactly once on each of its local days.
+type Daily struct {
+	// At is the time of day, read as a wall clock time in Location, so 02:00:00Z is 02:00 local time.
+	At dte.Time
+	// Location is the time zone the time of day and the days are in.
+	Location *time.Location
+	// Weekdays are the days of the week to run on, or every day when empty.
+	Weekdays []time.Weekday
+	// Recurrence picks the days to run on by the dates of its occurrences when its Start is set, instead of Weekdays.
+	Recurrence dte.Recurrence
+	// Jitter is the longest random delay added to each run, to spread the load of many schedulers, shorter than a day.
+	Jitter time.Duration
+	// Tolerance is how late a run may start, after its jitter, before it counts as missed, or DefaultTolerance when
+	// zero.
+	Tolerance time.Duration
+	// CatchUp is what happens with missed runs.
+	CatchUp CatchUp
+	// LastRun is the scheduled time of the last run before this process started, such as a stored Run.Scheduled,
+	// so the runs missed since then are caught up. When zero, only runs after the start of Run are made.
+	LastRun time.Time
+	// Grace is how long a running job keeps its context after Run is stopped, before the context is canceled.
+	Grace time.Duration
+	// Clock is where the time comes from, or dte.SystemClock when nil.
+	Clock dte.Clock
+}
+
+// Run is a run of a job.
+type Run struct {
+	// Date is the local date the run is for.
+	Date dte.Date
+	// Scheduled is the time the run was scheduled at, without its jitter.
+	Scheduled time.Time
+	// Missed is set when the run catches up on a missed one.
+	Missed bool
+}
+
+// Job is the work a Scheduler runs. Its context is canceled when the Grace after stopping the Scheduler has passed.
+type Job func(ctx context.Context, run Run)
+
+// Scheduler runs a job once a day, or on the days picked by its Daily, at a wall clock time of day.
+type Scheduler struct {
+	daily   Daily
+	cron    dte.Cron
+	job     Job
+	running atomic.Bool
+
+	// mu guards the dates of the recurrence expanded so far, and whether they are all of them.
+	mu    sync.Mutex
+	dates []dte.Date
+	ended bool
+}
+
+// New returns a Scheduler that runs the job at the times of the Daily.
+func New(daily Daily, job Job) (*Scheduler, error) {
+	switch {
+	case job == nil:
+		return nil, fmt.Errorf("%w: the job is nil", ErrNewScheduler)
+	case daily.Location == nil:
+		return nil, fmt.Errorf("%w: the location is nil", ErrNewScheduler)
+	case len(daily.Weekdays) > 0 && !daily.Recurrence.Start.IsZero():
+		return nil, fmt.Errorf("%w: weekdays and a recurrence can not be combined", ErrNewScheduler)
+	case daily.Jitter < 0 || daily.Jitter >= oneDay:
+		return nil, fmt.Errorf("%w: jitter %s is not between 0 and a day", ErrNewScheduler, daily.Jitter)
+	case daily.Tolerance < 0 || daily.Grace < 0:
+		return nil, fmt.Errorf("%w: the tolerance and grace can not be negative", ErrNewScheduler)
+	case daily.CatchUp < CatchUpSkip || daily.CatchUp > CatchUpAll:
+		return nil, fmt.Errorf("%w: unknown catch up %d", ErrNewScheduler, daily.CatchUp)
+	}
+
+	weekdays := "*"
+
+	if len(daily.Weekdays) > 0 {
+		numbers := make([]string, 0, len(daily.Weekdays))
+
+		for _, weekday := range daily.Weekdays {
+			if weekday < time.Sunday || weekday > time.Saturday {
+				return nil, fmt.Errorf("%w: unknown weekday %d", ErrNewScheduler, weekday)
+			}
+
+			numbers = append(numbers, strconv.Itoa(int(weekday)))
+		}
+
+		weekdays = strings.Join(numbers, ",")
+	}
+
+	hour, minute, second := daily.At.Clock()
+
+	cron, err := dte.ParseCron(fmt.Sprintf("%d %d %d * * %s", second, minute, hour, weekdays))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNewScheduler, err)
+	}
+
+	if daily.Tolerance == 0 {
+		daily.Tolerance = DefaultTolerance
+	}
+
+	if daily.Clock == nil {
+		daily.Clock = dte.SystemClock()
+	}
+
+	daily.Weekdays = slices.Clone(daily.Weekdays)
+
+	return &Scheduler{
+		daily:   daily,
+		cron:    cron,
+		job:     job,
+		running: atomic.Bool{},
+		mu:      sync.Mutex{},
+		dates:   nil,
+		ended:   false,
+	}, nil
+}
+
+// Next returns the first run scheduled after the given time, or false when the recurrence has ended.
+func (s *Scheduler) Next(after time.Time) (Run, bool) {
+	if s.daily.Recurrence.Start.IsZero() {
+		scheduled, ok := s.cron.Next(after, s.daily.Location)
+
+		return runAt(scheduled), ok
+	}
+
+	year, month, day := after.In(s.daily.Location).Date()
+	from := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	for i := s.searchDates(from); ; i++ {
+		date, ok := s.recurrenceDate(i)
+		if !ok {
+			return Run{}, false
+		}
+
+		for scheduled := range s.cron.OccurrencesOn(date, s.daily.Location) {
+			if scheduled.After(after) {
+				return runAt(scheduled), true
+			}
+		}
+	}
+}
+
+// searchDates returns the index of the first date of the recurrence on or after the date.
+func (s *Scheduler) searchDates(from time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for !s.ended && (len(s.dates) == 0 || s.dates[len(s.dates)-1].Before(from)) {
+		s.expandLocked()
+	}
+
+	i, _ := slices.BinarySearchFunc(s.dates, from, func(date dte.Date, from time.Time) int {
+		return date.Compare(from)
+	})
+
+	return i
+}
+
+// recurrenceDate returns the ith date of the recurrence, or false when it has ended before.
+func (s *Scheduler) recurrenceDate(i int) (dte.Date, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for !s.ended && i >= len(s.dates) {
+		s.expandLocked()
+	}
+
+	if i >= len(s.dates) {
+		return dte.Date{}, false
+	}
+
+	return s.dates[i], true
+}
+
+// expandLocked expands twice as many dates of the recurrence as before, so expanding it again from its start each
+// time costs as much as expanding it once.
+func (s *Scheduler) expandLocked() {
+	want := max(2*len(s.dates), recurrenceBatch)
+	dates := make([]dte.Date, 0, want)
+
+	for date := range s.daily.Recurrence.Dates() {
+		if len(dates) == want {
+			break
+		}
+
+		dates = append(dates, date)
+	}
+
+	s.dates, s.ended = dates, len(dates) < want
+}
+
+// Run runs the job at its scheduled times until the context is done or the recurrence has ended. Runs are made one
+// at a time, and once the context is done no new run starts and Run returns the error of the context when the
+// running job, if any, has returned.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if !s.running.CompareAndSwap(false, true) {
+		return ErrRunning
+	}
+	defer s.running.Store(false)
+
+	last := s.daily.LastRun
+	if last.IsZero() {
+		last = s.daily.Clock.Now()
+	}
+
+	for {
+		next, ok := s.Next(last)
+		if !ok {
+			return nil
+		}
+
+		err := s.wait(ctx, next.Scheduled.Add(s.jitter()))
+		if err != nil {
+			return err
+		}
+
+		var runs []Run
+
+		runs, last = s.due(last, s.daily.Clock.Now())
+
+		for _, run := range runs {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			s.execute(ctx, run)
+		}
+	}
+}
+
+// wait waits until the time or until the context is done. A timer does not run while the machine is asleep, so it
+// waits half the Tolerance at a time and looks at the wall clock again, and a run is not missed for the sleep alone.
+func (s *Scheduler) wait(ctx context.Context, until time.Time) error {
+	step := max(s.daily.Tolerance/2, time.Second) //nolint:mnd
+
+	for remaining := until.Sub(s.daily.Clock.Now()); remaining > 0; remaining = until.Sub(s.daily.Clock.Now()) {
+		err := s.sleep(ctx, min(remaining, step))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sleep waits for the duration or until the context is done.
+func (s *Scheduler) sleep(ctx context.Context, d time.Duration) error {
+	timer := s.daily.Clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C():
+		return nil
+	}
+}
+
+// due returns the runs scheduled after the last one up to now that are to be made under the catch up policy, and
+// the scheduled time of the latest of them, made or not. The clock may have gone back, so there may be none.
+func (s *Scheduler) due(last time.Time, now time.Time) ([]Run, time.Time) {
+	var missed, onTime []Run
+
+	for run, ok := s.Next(last); ok && !run.Scheduled.After(now); run, ok = s.Next(last) {
+		last = run.Scheduled
+
+		if now.Sub(run.Scheduled) > s.daily.Jitter+s.daily.Tolerance {
+			run.Missed = true
+			missed = append(missed, run)
+		} else {
+			onTime = append(onTime, run)
+		}
+	}
+
+	switch s.daily.CatchUp {
+	case CatchUpSkip:
+		missed = nil
+	case CatchUpLatest:
+		if len(missed) > 0 {
+			missed = missed[len(missed)-1:]
+		}
+	case CatchUpAll:
+	}
+
+	return append(missed, onTime...), last
+}
+
+// execute runs the job, canceling its context once the Grace has passed after the context of Run is done.
+func (s *Scheduler) execute(ctx context.Context, run Run) {
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, func() {
+		timer := s.daily.Clock.NewTimer(s.daily.Grace)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C():
+			cancel()
+		case <-jobCtx.Done():
+		}
+	})
+	defer stop()
+
+	s.job(jobCtx, run)
+}
+
+// jitter returns a random delay shorter than the Jitter.
+func (s *Scheduler) jitter() time.Duration {
+	if s.daily.Jitter <= 0 {
+		return 0
+	}
+
+	return rand.N(s.daily.Jitter) //nolint:gosec
+}
+
+// runAt returns the run scheduled at the time.
+func runAt(scheduled time.Time) Run {
+	year, month, day := scheduled.Date()
+
+	return Run{
+		Date:      dte.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)},
+		Scheduled: scheduled,
+		Missed:    false,
+	}
+}
@@ -0,0 +1,498 @@
+package dteschedule_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterHoburg/go-date-and-time-extension/dte"
+	"github.com/peterHoburg/go-date-and-time-extension/dteschedule"
+)
+
+func ExampleScheduler_Next() {
+	at, err := dte.NewTime("01:30:00Z")
+	if err != nil {
+		return
+	}
+
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		return
+	}
+
+	scheduler, err := dteschedule.New(
+		dteschedule.Daily{At: at, Location: london},
+		func(context.Context, dteschedule.Run) {},
+	)
+	if err != nil {
+		return
+	}
+
+	// 01:30 is skipped on March 31st and repeated on October 27th, and runs once on each day.
+	for _, after := range []time.Time{
+		time.Date(2024, time.March, 30, 12, 0, 0, 0, london),
+		time.Date(2024, time.March, 31, 0, 0, 0, 0, london),
+		time.Date(2024, time.October, 27, 0, 0, 0, 0, london),
+		time.Date(2024, time.October, 27, 1, 45, 0, 0, london),
+	} {
+		run, _ := scheduler.Next(after)
+		fmt.Println(run.Date, run.Scheduled.Format(time.RFC3339))
+	}
+
+	// Output:
+	// 2024-03-31 2024-03-31T02:00:00+01:00
+	// 2024-03-31 2024-03-31T02:00:00+01:00
+	// 2024-10-27 2024-10-27T01:30:00+01:00
+	// 2024-10-28 2024-10-28T01:30:00Z
+}
+
+func TestSchedulerRun(t *testing.T) {
+	t.Parallel()
+
+	london := mustLoad(t, "Europe/London")
+	clock := dte.NewFakeClock(time.Date(2024, time.March, 29, 12, 0, 0, 0, london))
+	runs := make(chan dteschedule.Run, 10)
+
+	scheduler := mustNew(t, dteschedule.Daily{At: mustTime(t, "02:00:00Z"), Location: london, Clock: clock}, runs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- scheduler.Run(ctx) }()
+
+	// Step through the spring forward in hours, so every run is on time.
+	for range 3 * 24 {
+		waitForTimer(t, clock)
+		clock.Advance(time.Hour)
+	}
+
+	cancel()
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+
+	close(runs)
+
+	want := []string{
+		"2024-03-30 2024-03-30T02:00:00Z false",
+		"2024-03-31 2024-03-31T02:00:00+01:00 false",
+		"2024-04-01 2024-04-01T02:00:00+01:00 false",
+	}
+
+	if got := formatRuns(runs); got != strings.Join(want, "\n") {
+		t.Errorf("Run() runs =\n%s\nwant\n%s", got, strings.Join(want, "\n"))
+	}
+}
+
+func TestSchedulerCatchUp(t *testing.T) {
+	t.Parallel()
+
+	newYork := mustLoad(t, "America/New_York")
+	now := time.Date(2024, time.November, 4, 12, 0, 0, 0, newYork)
+
+	tests := []struct {
+		name    string
+		catchUp dteschedule.CatchUp
+		lastRun time.Time
+		want    []string
+	}{
+		{
+			name:    "skip",
+			catchUp: dteschedule.CatchUpSkip,
+			lastRun: time.Date(2024, time.November, 1, 9, 0, 0, 0, newYork),
+			want:    nil,
+		},
+		{
+			name:    "latest",
+			catchUp: dteschedule.CatchUpLatest,
+			lastRun: time.Date(2024, time.November, 1, 9, 0, 0, 0, newYork),
+			want:    []string{"2024-11-04 2024-11-04T09:00:00-05:00 true"},
+		},
+		{
+			name:    "all",
+			catchUp: dteschedule.CatchUpAll,
+			lastRun: time.Date(2024, time.November, 1, 9, 0, 0, 0, newYork),
+			want: []string{
+				"2024-11-02 2024-11-02T09:00:00-04:00 true",
+				"2024-11-03 2024-11-03T09:00:00-05:00 true",
+				"2024-11-04 2024-11-04T09:00:00-05:00 true",
+			},
+		},
+		{
+			name:    "nothing missed",
+			catchUp: dteschedule.CatchUpAll,
+			lastRun: time.Date(2024, time.November, 4, 9, 0, 0, 0, newYork),
+			want:    nil,
+		},
+		{
+			name:    "no last run",
+			catchUp: dteschedule.CatchUpAll,
+			lastRun: time.Time{},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clock := dte.NewFakeClock(now)
+			runs := make(chan dteschedule.Run, 10)
+			scheduler := mustNew(t, dteschedule.Daily{
+				At:       mustTime(t, "09:00:00Z"),
+				Location: newYork,
+				CatchUp:  tt.catchUp,
+				LastRun:  tt.lastRun,
+				Clock:    clock,
+			}, runs)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+
+			go func() { done <- scheduler.Run(ctx) }()
+
+			waitForTimer(t, clock)
+			cancel()
+			<-done
+			close(runs)
+
+			if got := formatRuns(runs); got != strings.Join(tt.want, "\n") {
+				t.Errorf("Run() runs =\n%s\nwant\n%s", got, strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestSchedulerMissedWhileAsleep(t *testing.T) {
+	t.Parallel()
+
+	clock := dte.NewFakeClock(time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC))
+	runs := make(chan dteschedule.Run, 10)
+	scheduler := mustNew(t, dteschedule.Daily{
+		At:       mustTime(t, "18:00:00Z"),
+		Location: time.UTC,
+		Jitter:   10 * time.Minute,
+		CatchUp:  dteschedule.CatchUpLatest,
+		Clock:    clock,
+	}, runs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- scheduler.Run(ctx) }()
+
+	// The first run is on time within its jitter, then the machine sleeps through two runs.
+	waitForTimer(t, clock)
+	clock.Advance(6*time.Hour + 10*time.Minute)
+	waitForTimer(t, clock)
+	clock.Advance(2*24*time.Hour + time.Hour)
+	waitForTimer(t, clock)
+	cancel()
+	<-done
+	close(runs)
+
+	want := "2024-01-01 2024-01-01T18:00:00Z false\n2024-01-03 2024-01-03T18:00:00Z true"
+	if got := formatRuns(runs); got != want {
+		t.Errorf("Run() runs =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// suspendingClock is a Clock whose timers, like those of the system, do not run while the machine is asleep.
+type suspendingClock struct {
+	wall      *dte.FakeClock
+	monotonic *dte.FakeClock
+}
+
+func (c suspendingClock) Now() time.Time { return c.wall.Now() }
+
+func (c suspendingClock) Today(location *time.Location) dte.Date { return c.wall.Today(location) }
+
+func (c suspendingClock) NewTimer(d time.Duration) dte.Timer { return c.monotonic.NewTimer(d) }
+
+// advance moves the time on while the machine is awake.
+func (c suspendingClock) advance(d time.Duration) {
+	c.wall.Advance(d)
+	c.monotonic.Advance(d)
+}
+
+func TestSchedulerWakeFromSuspend(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	clock := suspendingClock{wall: dte.NewFakeClock(start), monotonic: dte.NewFakeClock(start)}
+	runs := make(chan dteschedule.Run, 10)
+	scheduler := mustNew(t, dteschedule.Daily{
+		At:       mustTime(t, "18:00:00Z"),
+		Location: time.UTC,
+		Clock:    clock,
+	}, runs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- scheduler.Run(ctx) }()
+
+	// The machine sleeps through many timer intervals and past the run, which the next timer finds still on time.
+	waitForTimer(t, clock.monotonic)
+	clock.advance(time.Hour)
+	waitForTimer(t, clock.monotonic)
+	clock.wall.Advance(5*time.Hour + 20*time.Second)
+	clock.advance(dteschedule.DefaultTolerance / 2)
+
+	select {
+	case run := <-runs:
+		if want := "2024-01-01 2024-01-01T18:00:00Z false"; formatRun(run) != want {
+			t.Errorf("Run() run = %s, want %s", formatRun(run), want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Run() made no run after waking")
+	}
+
+	cancel()
+	<-done
+}
+
+func TestSchedulerNext(t *testing.T) {
+	t.Parallel()
+
+	berlin := mustLoad(t, "Europe/Berlin")
+
+	rule, err := dte.ParseRRule("FREQ=MONTHLY;BYDAY=1MO;COUNT=3")
+	if err != nil {
+		t.Fatalf("ParseRRule() error = %v", err)
+	}
+
+	daily, err := dte.ParseRRule("FREQ=DAILY;INTERVAL=2")
+	if err != nil {
+		t.Fatalf("ParseRRule() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		daily dteschedule.Daily
+		want  []string
+	}{
+		{
+			name:  "weekdays",
+			daily: dteschedule.Daily{Location: berlin, Weekdays: []time.Weekday{time.Tuesday, time.Saturday}},
+			want:  []string{"2024-01-02", "2024-01-06", "2024-01-09", "2024-01-13"},
+		},
+		{
+			name: "recurrence",
+			daily: dteschedule.Daily{
+				Location:   berlin,
+				Recurrence: dte.Recurrence{Start: time.Date(2023, time.December, 4, 0, 0, 0, 0, berlin), Rules: []dte.RRule{rule}},
+			},
+			want: []string{"2024-01-01", "2024-02-05"},
+		},
+		{
+			name: "recurrence from years before",
+			daily: dteschedule.Daily{
+				Location:   berlin,
+				Recurrence: dte.Recurrence{Start: time.Date(2020, time.January, 1, 0, 0, 0, 0, berlin), Rules: []dte.RRule{daily}},
+			},
+			want: []string{"2024-01-02", "2024-01-04", "2024-01-06", "2024-01-08"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.daily.At = mustTime(t, "07:15:00Z")
+			scheduler := mustNew(t, tt.daily, nil)
+
+			var got []string
+
+			after := time.Date(2024, time.January, 1, 0, 0, 0, 0, berlin)
+
+			for run, ok := scheduler.Next(after); ok && len(got) < len(tt.want); run, ok = scheduler.Next(after) {
+				if run.Scheduled.Format("15:04") != "07:15" || run.Scheduled.Location() != berlin {
+					t.Errorf("Next() = %v, want 07:15 in Europe/Berlin", run.Scheduled)
+				}
+
+				got = append(got, run.Date.String())
+				after = run.Scheduled
+			}
+
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("Next() dates = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedulerGracefulShutdown(t *testing.T) {
+	t.Parallel()
+
+	clock := dte.NewFakeClock(time.Date(2024, time.January, 1, 23, 0, 0, 0, time.UTC))
+	started := make(chan struct{})
+	stopped := make(chan error, 1)
+
+	scheduler, err := dteschedule.New(dteschedule.Daily{
+		At:       mustTime(t, "00:00:00Z"),
+		Location: time.UTC,
+		Grace:    time.Minute,
+		Clock:    clock,
+	}, func(ctx context.Context, _ dteschedule.Run) {
+		close(started)
+		<-ctx.Done()
+		stopped <- ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- scheduler.Run(ctx) }()
+
+	waitForTimer(t, clock)
+	clock.Advance(time.Hour)
+	<-started
+
+	cancel()
+
+	// The job keeps its context for the grace period, and Run waits for it to return.
+	waitForTimer(t, clock)
+
+	select {
+	case err := <-done:
+		t.Fatalf("Run() returned %v before the job did", err)
+	case err := <-stopped:
+		t.Fatalf("job context ended with %v before the grace period", err)
+	default:
+	}
+
+	clock.Advance(time.Minute)
+
+	if err := <-stopped; !errors.Is(err, context.Canceled) {
+		t.Errorf("job context error = %v, want %v", err, context.Canceled)
+	}
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSchedulerAlreadyRunning(t *testing.T) {
+	t.Parallel()
+
+	clock := dte.NewFakeClock(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	scheduler := mustNew(t, dteschedule.Daily{At: mustTime(t, "12:00:00Z"), Location: time.UTC, Clock: clock}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- scheduler.Run(ctx) }()
+
+	waitForTimer(t, clock)
+
+	if err := scheduler.Run(ctx); !errors.Is(err, dteschedule.ErrRunning) {
+		t.Errorf("Run() error = %v, want %v", err, dteschedule.ErrRunning)
+	}
+
+	cancel()
+	<-done
+}
+
+func TestNewErrors(t *testing.T) {
+	t.Parallel()
+
+	job := func(context.Context, dteschedule.Run) {}
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		daily dteschedule.Daily
+		job   dteschedule.Job
+	}{
+		{name: "no job", daily: dteschedule.Daily{Location: time.UTC}, job: nil},
+		{name: "no location", daily: dteschedule.Daily{}, job: job},
+		{
+			name:  "weekdays and recurrence",
+			daily: dteschedule.Daily{Location: time.UTC, Weekdays: []time.Weekday{1}, Recurrence: dte.Recurrence{Start: start}},
+			job:   job,
+		},
+		{name: "unknown weekday", daily: dteschedule.Daily{Location: time.UTC, Weekdays: []time.Weekday{7}}, job: job},
+		{name: "negative jitter", daily: dteschedule.Daily{Location: time.UTC, Jitter: -time.Second}, job: job},
+		{name: "jitter of a day", daily: dteschedule.Daily{Location: time.UTC, Jitter: 24 * time.Hour}, job: job},
+		{name: "negative grace", daily: dteschedule.Daily{Location: time.UTC, Grace: -time.Second}, job: job},
+		{name: "unknown catch up", daily: dteschedule.Daily{Location: time.UTC, CatchUp: 3}, job: job},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dteschedule.New(tt.daily, tt.job)
+			if !errors.Is(err, dteschedule.ErrNewScheduler) {
+				t.Errorf("New() error = %v, want %v", err, dteschedule.ErrNewScheduler)
+			}
+		})
+	}
+}
+
+func mustNew(t *testing.T, daily dteschedule.Daily, runs chan<- dteschedule.Run) *dteschedule.Scheduler {
+	t.Helper()
+
+	scheduler, err := dteschedule.New(daily, func(_ context.Context, run dteschedule.Run) { runs <- run })
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return scheduler
+}
+
+// waitForTimer waits until the scheduler is waiting on a timer of the clock.
+func waitForTimer(t *testing.T, clock *dte.FakeClock) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := clock.WaitForTimers(ctx, 1); err != nil {
+		t.Fatalf("WaitForTimers() error = %v", err)
+	}
+}
+
+func mustTime(t *testing.T, value string) dte.Time {
+	t.Helper()
+
+	parsed, err := dte.NewTime(value)
+	if err != nil {
+		t.Fatalf("NewTime() error = %v", err)
+	}
+
+	return parsed
+}
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	return location
+}
+
+func formatRuns(runs <-chan dteschedule.Run) string {
+	lines := make([]string, 0, len(runs))
+
+	for run := range runs {
+		lines = append(lines, formatRun(run))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatRun(run dteschedule.Run) string {
+	return fmt.Sprint(run.Date, " ", run.Scheduled.Format(time.RFC3339), " ", run.Missed)
+}